@@ -0,0 +1,101 @@
+// Package testutil holds the in-memory stores and bank keeper shared by the keeper tests of the modules.
+package testutil
+
+import (
+	"testing"
+	
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+)
+
+const Denom = "uflix"
+
+var (
+	paramsKey  = sdk.NewKVStoreKey("params")
+	paramsTKey = sdk.NewTransientStoreKey("transient_params")
+)
+
+// BankKeeper keeps the balances in memory so the tests can follow every coin the keepers move.
+type BankKeeper struct {
+	balances map[string]sdk.Coins
+}
+
+func NewBankKeeper() BankKeeper {
+	return BankKeeper{balances: make(map[string]sdk.Coins)}
+}
+
+func (b BankKeeper) AddCoins(_ sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, error) {
+	b.balances[addr.String()] = b.balances[addr.String()].Add(amt...)
+	return b.balances[addr.String()], nil
+}
+
+func (b BankKeeper) SubtractCoins(_ sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, error) {
+	remaining, negative := b.balances[addr.String()].SafeSub(amt)
+	if negative {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "%s has %s", addr, b.balances[addr.String()])
+	}
+	
+	b.balances[addr.String()] = remaining
+	return remaining, nil
+}
+
+func (b BankKeeper) SendCoins(ctx sdk.Context, from, to sdk.AccAddress, amt sdk.Coins) error {
+	if _, err := b.SubtractCoins(ctx, from, amt); err != nil {
+		return err
+	}
+	
+	_, err := b.AddCoins(ctx, to, amt)
+	return err
+}
+
+func (b BankKeeper) SetBalance(addr sdk.AccAddress, amount int64) {
+	b.balances[addr.String()] = sdk.NewCoins(Coin(amount))
+}
+
+func (b BankKeeper) Balance(addr sdk.AccAddress) sdk.Int {
+	return b.balances[addr.String()].AmountOf(Denom)
+}
+
+func Coin(amount int64) sdk.Coin {
+	return sdk.NewInt64Coin(Denom, amount)
+}
+
+func NewAddr() sdk.AccAddress {
+	return sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+}
+
+// NewContext mounts the stores of the keys and of the params on an in-memory database. The bech32
+// prefix is set to the chain, so the keepers act as the primary or the licensee chain.
+func NewContext(t *testing.T, chain string, keys ...sdk.StoreKey) sdk.Context {
+	sdk.GetConfig().SetBech32PrefixForAccount(chain, chain+sdk.PrefixPublic)
+	
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	for _, key := range append(keys, paramsKey) {
+		ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	}
+	ms.MountStoreWithDB(paramsTKey, sdk.StoreTypeTransient, db)
+	if err := ms.LoadLatestVersion(); err != nil {
+		t.Fatal(err)
+	}
+	
+	return sdk.NewContext(ms, abci.Header{ChainID: chain}, false, log.NewNopLogger())
+}
+
+func NewSubspace(cdc *codec.Codec, name string) paramtypes.Subspace {
+	return paramtypes.NewSubspace(codec.NewAminoCodec(cdc), paramsKey, paramsTKey, name)
+}
+
+func RequireBalance(t *testing.T, bank BankKeeper, addr sdk.AccAddress, amount int64) {
+	t.Helper()
+	if got := bank.Balance(addr); !got.Equal(sdk.NewInt(amount)) {
+		t.Fatalf("expected balance %d%s of %s, got %s", amount, Denom, addr, got)
+	}
+}
@@ -0,0 +1,56 @@
+package nfts
+
+import (
+	"fmt"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker settles the ownership auctions that have ended and drops the co-owner proposals
+// whose window has passed. The license auctions are settled by xnfts which grants the license on
+// the licensee chain.
+func EndBlocker(ctx sdk.Context, k Keeper) {
+	for _, proposal := range k.DequeueExpiredProposals(ctx) {
+		k.DeleteProposal(ctx, proposal)
+		
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				EventTypeProposalExpired,
+				sdk.NewAttribute(AttributePrimaryNFTID, proposal.PrimaryNFTID),
+				sdk.NewAttribute(AttributeProposalID, fmt.Sprintf("%d", proposal.ID)),
+				sdk.NewAttribute(AttributeAction, proposal.Action),
+			),
+		)
+	}
+	
+	for _, auction := range k.DequeueEndedAuctions(ctx, AuctionKindOwnership) {
+		cacheCtx, write := ctx.CacheContext()
+		royalty, err := k.SettleAuction(cacheCtx, auction)
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("failed to settle auction %d: %s", auction.ID, err))
+			
+			cacheCtx, write = ctx.CacheContext()
+			if err := k.CloseAuction(cacheCtx, auction); err != nil {
+				// the auction stays queued and is closed again in the next block
+				k.Logger(ctx).Error(fmt.Sprintf("failed to close auction %d: %s", auction.ID, err))
+				k.InsertAuctionQueue(ctx, auction)
+				continue
+			}
+			write()
+			continue
+		}
+		write()
+		
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				EventTypeAuctionSettled,
+				sdk.NewAttribute(AttributePrimaryNFTID, auction.PrimaryNFTID),
+				sdk.NewAttribute(AttributeAuctionID, fmt.Sprintf("%d", auction.ID)),
+				sdk.NewAttribute(AttributeAuctionKind, auction.Kind),
+				sdk.NewAttribute(AttributeBuyer, auction.HighestBidder),
+				sdk.NewAttribute(AttributeBid, auction.HighestBid.String()),
+				sdk.NewAttribute(AttributeRoyalty, royalty.String()),
+			),
+		)
+	}
+}
@@ -9,17 +9,84 @@ const (
 	CoCoContext     = types.CoCoContext
 	FreeFlixContext = types.FreeFlixContext
 	
-	ModuleName = types.ModuleName
-	RouterKey  = types.RouterKey
-	StoreKey   = types.StoreKey
+	LicenseStatusActive  = types.LicenseStatusActive
+	LicenseStatusExpired = types.LicenseStatusExpired
+	
+	AuctionKindOwnership  = types.AuctionKindOwnership
+	AuctionKindLicense    = types.AuctionKindLicense
+	AuctionTypeEnglish    = types.AuctionTypeEnglish
+	AuctionTypeDutch      = types.AuctionTypeDutch
+	AuctionStatusActive   = types.AuctionStatusActive
+	AuctionStatusSettling = types.AuctionStatusSettling
+	
+	ProposalActionLicense     = types.ProposalActionLicense
+	ProposalActionTransfer    = types.ProposalActionTransfer
+	ProposalActionUpdateTerms = types.ProposalActionUpdateTerms
+	ProposalActionBurn        = types.ProposalActionBurn
+	
+	PlatformTwitter   = types.PlatformTwitter
+	PlatformYouTube   = types.PlatformYouTube
+	PlatformInstagram = types.PlatformInstagram
+	PlatformGeneric   = types.PlatformGeneric
+	DefaultPlatform   = types.DefaultPlatform
+	
+	ModuleName        = types.ModuleName
+	DefaultParamspace = types.DefaultParamspace
+	RouterKey         = types.RouterKey
+	StoreKey          = types.StoreKey
 )
 
 type (
 	Keeper       = keeper.Keeper
 	GenesisState = types.GenesisState
 	
-	MsgMintTweetNFT = types.MsgMintTweetNFT
-	BaseTweetNFT    = types.BaseTweetNFT
+	MsgMintTweetNFT        = types.MsgMintTweetNFT
+	MsgBatchMintTweetNFTs  = types.MsgBatchMintTweetNFTs
+	MintEntry              = types.MintEntry
+	MsgUpdateLicenseCap    = types.MsgUpdateLicenseCap
+	BaseTweetNFT           = types.BaseTweetNFT
+	LicenseGrant           = types.LicenseGrant
+	LicenseTerms           = types.LicenseTerms
+	MsgListTweetNFT        = types.MsgListTweetNFT
+	MsgDelistTweetNFT      = types.MsgDelistTweetNFT
+	MsgBuyTweetNFT         = types.MsgBuyTweetNFT
+	MsgTransferTweetNFT    = types.MsgTransferTweetNFT
+	Listing                = types.Listing
+	BankKeeper             = types.BankKeeper
+	NFTHooks               = types.NFTHooks
+	Params                 = types.Params
+	Auction                = types.Auction
+	MsgCreateAuction       = types.MsgCreateAuction
+	MsgPlaceBid            = types.MsgPlaceBid
+	MsgCancelAuction       = types.MsgCancelAuction
+	RevenueSplit           = types.RevenueSplit
+	Payout                 = types.Payout
+	MsgUpdateRevenueSplits = types.MsgUpdateRevenueSplits
+	MsgSetCoOwners         = types.MsgSetCoOwners
+	MsgSubmitProposal      = types.MsgSubmitProposal
+	MsgApproveProposal     = types.MsgApproveProposal
+	Proposal               = types.Proposal
+	LicenseApproval        = types.LicenseApproval
+	NFTApproval            = types.NFTApproval
+	OperatorApproval       = types.OperatorApproval
+	MsgApproveNFT          = types.MsgApproveNFT
+	MsgRevokeNFTApproval   = types.MsgRevokeNFTApproval
+	MsgSetOperator         = types.MsgSetOperator
+	Bundle                 = types.Bundle
+	BundleMember           = types.BundleMember
+	MsgCreateBundle        = types.MsgCreateBundle
+	MsgDeleteBundle        = types.MsgDeleteBundle
+	Metadata               = types.Metadata
+	MsgUpdateNFTMetadata   = types.MsgUpdateNFTMetadata
+	Collection             = types.Collection
+	MsgCreateCollection    = types.MsgCreateCollection
+	MsgMintEdition         = types.MsgMintEdition
+	HandleAttestation      = types.HandleAttestation
+	MsgAttestHandle        = types.MsgAttestHandle
+	Profile                = types.Profile
+	MsgSetProfile          = types.MsgSetProfile
+	
+	MsgRevokeHandleAttestation = types.MsgRevokeHandleAttestation
 )
 
 var (
@@ -28,16 +95,134 @@ var (
 	GetContextOfCurrentChain = types.GetContextOfCurrentChain
 	GetPrimaryNFTID          = types.GetPrimaryNFTID
 	GetSecondaryNFTID        = types.GetSecondaryNFTID
+	NewLicenseGrant          = types.NewLicenseGrant
+	NewLicenseTerms          = types.NewLicenseTerms
+	DefaultLicenseTerms      = types.DefaultLicenseTerms
+	NewListing               = types.NewListing
+	ModuleAddress            = types.ModuleAddress
+	NewParams                = types.NewParams
+	DefaultParams            = types.DefaultParams
+	ParamKeyTable            = types.ParamKeyTable
+	NewAuction               = types.NewAuction
+	NewRevenueSplit          = types.NewRevenueSplit
+	ParseRevenueSplits       = types.ParseRevenueSplits
+	ValidateRevenueSplits    = types.ValidateRevenueSplits
+	CoOwnedAddress           = types.CoOwnedAddress
+	NewProposal              = types.NewProposal
+	NewLicenseApproval       = types.NewLicenseApproval
+	NewNFTApproval           = types.NewNFTApproval
+	NewOperatorApproval      = types.NewOperatorApproval
+	NewBundle                = types.NewBundle
+	NewBundleMember          = types.NewBundleMember
+	ParseBundleMembers       = types.ParseBundleMembers
+	ValidateBundleMembers    = types.ValidateBundleMembers
+	NewMetadata              = types.NewMetadata
+	IsValidContentHash       = types.IsValidContentHash
+	IsValidPlatform          = types.IsValidPlatform
+	NormalizePlatform        = types.NormalizePlatform
+	NormalizeHandle          = types.NormalizeHandle
+	ValidateHandle           = types.ValidateHandle
+	ValidatePostID           = types.ValidatePostID
+	NewCollection            = types.NewCollection
+	ValidateCollectionID     = types.ValidateCollectionID
+	GetCollectionNFTID       = types.GetCollectionNFTID
+	GetEditionNFTID          = types.GetEditionNFTID
+	ParseContentHash         = types.ParseContentHash
+	DetectHashAlgorithm      = types.DetectHashAlgorithm
+	NewHandleAttestation     = types.NewHandleAttestation
+	NewMsgMintNFT            = types.NewMsgMintNFT
+	NewProfile               = types.NewProfile
 	
-	EventTypeMsgMintTweetNFT = types.EventTypeMsgMintTweetNFT
+	EventTypeMsgMintTweetNFT        = types.EventTypeMsgMintTweetNFT
+	EventTypeMsgUpdateLicenseCap    = types.EventTypeMsgUpdateLicenseCap
+	EventTypeMsgListTweetNFT        = types.EventTypeMsgListTweetNFT
+	EventTypeMsgDelistTweetNFT      = types.EventTypeMsgDelistTweetNFT
+	EventTypeMsgBuyTweetNFT         = types.EventTypeMsgBuyTweetNFT
+	EventTypeMsgTransferTweetNFT    = types.EventTypeMsgTransferTweetNFT
+	EventTypeMsgCreateAuction       = types.EventTypeMsgCreateAuction
+	EventTypeMsgPlaceBid            = types.EventTypeMsgPlaceBid
+	EventTypeMsgCancelAuction       = types.EventTypeMsgCancelAuction
+	EventTypeAuctionSettled         = types.EventTypeAuctionSettled
+	EventTypeMsgUpdateRevenueSplits = types.EventTypeMsgUpdateRevenueSplits
+	EventTypeMsgSetCoOwners         = types.EventTypeMsgSetCoOwners
+	EventTypeMsgSubmitProposal      = types.EventTypeMsgSubmitProposal
+	EventTypeMsgApproveProposal     = types.EventTypeMsgApproveProposal
+	EventTypeProposalExecuted       = types.EventTypeProposalExecuted
+	EventTypeProposalExpired        = types.EventTypeProposalExpired
+	EventTypeMsgApproveNFT          = types.EventTypeMsgApproveNFT
+	EventTypeMsgRevokeNFTApproval   = types.EventTypeMsgRevokeNFTApproval
+	EventTypeMsgSetOperator         = types.EventTypeMsgSetOperator
+	EventTypeMsgCreateBundle        = types.EventTypeMsgCreateBundle
+	EventTypeMsgDeleteBundle        = types.EventTypeMsgDeleteBundle
+	EventTypeMsgUpdateNFTMetadata   = types.EventTypeMsgUpdateNFTMetadata
+	EventTypeMsgCreateCollection    = types.EventTypeMsgCreateCollection
+	EventTypeMsgMintEdition         = types.EventTypeMsgMintEdition
+	EventTypeMsgAttestHandle        = types.EventTypeMsgAttestHandle
+	EventTypeMsgRevokeAttestation   = types.EventTypeMsgRevokeAttestation
+	EventTypeMsgSetProfile          = types.EventTypeMsgSetProfile
 	
 	AttributePrimaryNFTID   = types.AttributePrimaryNFTID
 	AttributeSecondaryNFTID = types.AttributeSecondaryNFTID
 	AttributeAssetID        = types.AttributeAssetID
 	AttributeTwitterHandle  = types.AttributeTwitterHandle
+	AttributeMaxLicensees   = types.AttributeMaxLicensees
+	AttributeSeller         = types.AttributeSeller
+	AttributeBuyer          = types.AttributeBuyer
+	AttributeRecipient      = types.AttributeRecipient
+	AttributePrice          = types.AttributePrice
+	AttributeCreator        = types.AttributeCreator
+	AttributeRoyalty        = types.AttributeRoyalty
+	AttributeAuctionID      = types.AttributeAuctionID
+	AttributeAuctionKind    = types.AttributeAuctionKind
+	AttributeBid            = types.AttributeBid
+	AttributeLicensee       = types.AttributeLicensee
+	AttributeRevenueSplits  = types.AttributeRevenueSplits
+	AttributeCoOwners       = types.AttributeCoOwners
+	AttributeThreshold      = types.AttributeThreshold
+	AttributeProposalID     = types.AttributeProposalID
+	AttributeAction         = types.AttributeAction
+	AttributeApproved       = types.AttributeApproved
+	AttributeOperator       = types.AttributeOperator
+	AttributeBundleID       = types.AttributeBundleID
+	AttributeContentHash    = types.AttributeContentHash
+	AttributePlatform       = types.AttributePlatform
+	AttributeCollectionID   = types.AttributeCollectionID
+	AttributeMasterNFTID    = types.AttributeMasterNFTID
+	AttributeEditionNumber  = types.AttributeEditionNumber
+	AttributeMaxEditions    = types.AttributeMaxEditions
+	AttributeVerifier       = types.AttributeVerifier
+	AttributeAttested       = types.AttributeAttested
+	AttributeVerified       = types.AttributeVerified
+	
+	ErrAssetIDAlreadyExist  = types.ErrAssetIDAlreadyExist
+	ErrInvalidLicense       = types.ErrInvalidLicense
+	ErrParamsNotFound       = types.ErrParamsNotFound
+	ErrNFTNotFound          = types.ErrNFTNotFound
+	ErrLicenseCapReached    = types.ErrLicenseCapReached
+	ErrListingNotFound      = types.ErrListingNotFound
+	ErrListingAlreadyExists = types.ErrListingAlreadyExists
+	ErrInvalidRoyaltyRate   = types.ErrInvalidRoyaltyRate
+	ErrAuctionNotFound      = types.ErrAuctionNotFound
+	ErrAuctionClosed        = types.ErrAuctionClosed
+	ErrInvalidBid           = types.ErrInvalidBid
+	ErrInvalidAuction       = types.ErrInvalidAuction
+	ErrInvalidRevenueSplits = types.ErrInvalidRevenueSplits
+	ErrProposalNotFound     = types.ErrProposalNotFound
+	ErrInvalidProposal      = types.ErrInvalidProposal
+	ErrInvalidCoOwners      = types.ErrInvalidCoOwners
+	ErrNFTApprovalNotFound  = types.ErrNFTApprovalNotFound
+	ErrBundleNotFound       = types.ErrBundleNotFound
+	ErrInvalidBundle        = types.ErrInvalidBundle
+	ErrInvalidMetadata      = types.ErrInvalidMetadata
+	ErrCollectionNotFound   = types.ErrCollectionNotFound
+	ErrInvalidCollection    = types.ErrInvalidCollection
+	ErrInvalidEdition       = types.ErrInvalidEdition
+	ErrEditionSupplyReached = types.ErrEditionSupplyReached
 	
-	ErrAssetIDAlreadyExist = types.ErrAssetIDAlreadyExist
-	ErrInvalidLicense      = types.ErrInvalidLicense
-	ErrParamsNotFound      = types.ErrParamsNotFound
-	ErrNFTNotFound         = types.ErrNFTNotFound
+	ErrContentHashAlreadyExist = types.ErrContentHashAlreadyExist
+	ErrAttestationNotFound     = types.ErrAttestationNotFound
+	ErrInvalidAttestation      = types.ErrInvalidAttestation
+	ErrProfileNotFound         = types.ErrProfileNotFound
+	ErrInvalidProfile          = types.ErrInvalidProfile
+	ErrNFTEscrowed             = types.ErrNFTEscrowed
 )
@@ -10,6 +10,43 @@ const (
 	FlagRevenueShare  = "revenue-share"
 	FlagTwitterHandle = "handle"
 	FlagAssetID       = "asset-id"
+	FlagMaxLicensees  = "max-licensees"
+	FlagRoyaltyRate   = "royalty-rate"
+	FlagFloorPrice    = "floor-price"
+	FlagChannel       = "channel"
+	FlagLicensee      = "licensee"
+	FlagRevenueSplits = "revenue-splits"
+	FlagRecipient     = "recipient"
+	
+	FlagExclusive          = "exclusive"
+	FlagDurationBlocks     = "duration-blocks"
+	FlagDurationSeconds    = "duration-seconds"
+	FlagPermittedChannels  = "permitted-channels"
+	FlagSublicensing       = "sublicensing"
+	FlagCommercialUse      = "commercial-use"
+	FlagSubscriptionPeriod = "subscription-period-blocks"
+	
+	FlagTitle       = "title"
+	FlagDescription = "description"
+	FlagMediaType   = "media-type"
+	FlagDuration    = "duration"
+	FlagContentURI  = "content-uri"
+	FlagContentHash = "content-hash"
+	FlagLanguage    = "language"
+	FlagTags        = "tags"
+	FlagContentFile = "content-file"
+	FlagHashAlgo    = "hash-algorithm"
+	FlagPlatform    = "platform"
+	
+	FlagCollection     = "collection"
+	FlagSchema         = "schema"
+	FlagMintPermission = "mint-permission"
+	FlagMinters        = "minters"
+	FlagMaxEditions    = "max-editions"
+	FlagExpirySeconds  = "expiry-seconds"
+	FlagDisplayName    = "display-name"
+	FlagAvatarURI      = "avatar-uri"
+	FlagPayoutAddress  = "payout-address"
 )
 
 var (
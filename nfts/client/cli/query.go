@@ -24,6 +24,29 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 	cmd.AddCommand(
 		GetCmdQueryTweetNFT(cdc),
 		GetCmdQueryTweetsByAccount(cdc),
+		GetCmdQueryTweetsByTag(cdc),
+		GetCmdQueryLicenseGrants(cdc),
+		GetCmdQueryListingsBySeller(cdc),
+		GetCmdQueryListingsByPrice(cdc),
+		GetCmdQueryListingsByHandle(cdc),
+		GetCmdQueryParams(cdc),
+		GetCmdQueryAuction(cdc),
+		GetCmdQueryAuctions(cdc),
+		GetCmdQueryProposals(cdc),
+		GetCmdQueryNFTApproval(cdc),
+		GetCmdQueryOperators(cdc),
+		GetCmdQueryBundle(cdc),
+		GetCmdQueryBundles(cdc),
+		GetCmdQueryCollection(cdc),
+		GetCmdQueryCollections(cdc),
+		GetCmdQueryCollectionNFTs(cdc),
+		GetCmdQueryEditions(cdc),
+		GetCmdQueryNFTByContentHash(cdc),
+		GetCmdQueryHandleAttestation(cdc),
+		GetCmdQueryHandleAttestations(cdc),
+		GetCmdQueryProfile(cdc),
+		GetCmdQueryProfiles(cdc),
+		GetCmdQueryProfileNFTs(cdc),
 	)
 	
 	return cmd
@@ -71,3 +94,472 @@ func GetCmdQueryTweetsByAccount(cdc *codec.Codec) *cobra.Command {
 	return flags.GetCommands(cmd)[0]
 	
 }
+
+func GetCmdQueryTweetsByTag(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nfts-by-tag [tag]",
+		Short: "Get NFTs tagged with the tag",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, types.QueryTweetNFTsByTag, args[0]), nil)
+			if err != nil {
+				return err
+			}
+			
+			var tweetNFTs []types.BaseTweetNFT
+			cdc.MustUnmarshalJSON(res, &tweetNFTs)
+			return cliCtx.PrintOutput(tweetNFTs)
+		},
+	}
+	return flags.GetCommands(cmd)[0]
+}
+
+func GetCmdQueryLicenseGrants(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "licenses [primary-nft-id]",
+		Short: "Get license grants of primary NFT",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, types.QueryLicenseGrants, args[0]), nil)
+			if err != nil {
+				return err
+			}
+			
+			var grants []types.LicenseGrant
+			cdc.MustUnmarshalJSON(res, &grants)
+			return cliCtx.PrintOutput(grants)
+		},
+	}
+	return flags.GetCommands(cmd)[0]
+}
+
+func GetCmdQueryListingsBySeller(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "listings-by-seller [address]",
+		Short: "Get active marketplace listings of seller",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return queryListings(cdc, fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, types.QueryListingsBySeller, args[0]))
+		},
+	}
+	return flags.GetCommands(cmd)[0]
+}
+
+func GetCmdQueryListingsByPrice(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "listings-by-price [min-price] [max-price]",
+		Short: "Get active marketplace listings priced within range",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return queryListings(cdc, fmt.Sprintf("custom/%s/%s/%s/%s", types.QuerierRoute, types.QueryListingsByPrice, args[0], args[1]))
+		},
+	}
+	return flags.GetCommands(cmd)[0]
+}
+
+func GetCmdQueryListingsByHandle(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "listings-by-handle [twitter-handle]",
+		Short: "Get active marketplace listings of twitter handle",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return queryListings(cdc, fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, types.QueryListingsByHandle, args[0]))
+		},
+	}
+	return flags.GetCommands(cmd)[0]
+}
+
+func queryListings(cdc *codec.Codec, route string) error {
+	cliCtx := context.NewCLIContext().WithCodec(cdc)
+	
+	res, _, err := cliCtx.QueryWithData(route, nil)
+	if err != nil {
+		return err
+	}
+	
+	var listings []types.Listing
+	cdc.MustUnmarshalJSON(res, &listings)
+	return cliCtx.PrintOutput(listings)
+}
+
+func GetCmdQueryParams(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Get nfts module parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParams), nil)
+			if err != nil {
+				return err
+			}
+			
+			var params types.Params
+			cdc.MustUnmarshalJSON(res, &params)
+			return cliCtx.PrintOutput(params)
+		},
+	}
+	return flags.GetCommands(cmd)[0]
+}
+
+func GetCmdQueryAuction(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auction [id]",
+		Short: "Get auction using auction id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, types.QueryAuction, args[0]), nil)
+			if err != nil {
+				return err
+			}
+			
+			var auction types.Auction
+			cdc.MustUnmarshalJSON(res, &auction)
+			return cliCtx.PrintOutput(auction)
+		},
+	}
+	return flags.GetCommands(cmd)[0]
+}
+
+func GetCmdQueryAuctions(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auctions",
+		Short: "Get all open auctions",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAuctions), nil)
+			if err != nil {
+				return err
+			}
+			
+			var auctions []types.Auction
+			cdc.MustUnmarshalJSON(res, &auctions)
+			return cliCtx.PrintOutput(auctions)
+		},
+	}
+	return flags.GetCommands(cmd)[0]
+}
+
+func GetCmdQueryProposals(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proposals [primary-nft-id]",
+		Short: "Get pending co-owner proposals of nft",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, types.QueryProposals, args[0]), nil)
+			if err != nil {
+				return err
+			}
+			
+			var proposals []types.Proposal
+			cdc.MustUnmarshalJSON(res, &proposals)
+			return cliCtx.PrintOutput(proposals)
+		},
+	}
+	return flags.GetCommands(cmd)[0]
+}
+
+func GetCmdQueryNFTApproval(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nft-approval [primary-nft-id]",
+		Short: "Get the address approved for nft",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, types.QueryNFTApproval, args[0]), nil)
+			if err != nil {
+				return err
+			}
+			
+			var approval types.NFTApproval
+			cdc.MustUnmarshalJSON(res, &approval)
+			return cliCtx.PrintOutput(approval)
+		},
+	}
+	return flags.GetCommands(cmd)[0]
+}
+
+func GetCmdQueryOperators(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "operators [owner-address]",
+		Short: "Get the operators of an owner",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, types.QueryOperators, args[0]), nil)
+			if err != nil {
+				return err
+			}
+			
+			var operators []types.OperatorApproval
+			cdc.MustUnmarshalJSON(res, &operators)
+			return cliCtx.PrintOutput(operators)
+		},
+	}
+	return flags.GetCommands(cmd)[0]
+}
+
+func GetCmdQueryBundle(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bundle [id]",
+		Short: "Get bundle using bundle id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, types.QueryBundle, args[0]), nil)
+			if err != nil {
+				return err
+			}
+			
+			var bundle types.Bundle
+			cdc.MustUnmarshalJSON(res, &bundle)
+			return cliCtx.PrintOutput(bundle)
+		},
+	}
+	return flags.GetCommands(cmd)[0]
+}
+
+func GetCmdQueryBundles(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bundles",
+		Short: "Get all bundles",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryBundles), nil)
+			if err != nil {
+				return err
+			}
+			
+			var bundles []types.Bundle
+			cdc.MustUnmarshalJSON(res, &bundles)
+			return cliCtx.PrintOutput(bundles)
+		},
+	}
+	return flags.GetCommands(cmd)[0]
+}
+
+func GetCmdQueryCollection(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "collection [id]",
+		Short: "Get collection using collection id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, types.QueryCollection, args[0]), nil)
+			if err != nil {
+				return err
+			}
+			
+			var collection types.Collection
+			cdc.MustUnmarshalJSON(res, &collection)
+			return cliCtx.PrintOutput(collection)
+		},
+	}
+	return flags.GetCommands(cmd)[0]
+}
+
+func GetCmdQueryCollections(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "collections",
+		Short: "Get all collections",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCollections), nil)
+			if err != nil {
+				return err
+			}
+			
+			var collections []types.Collection
+			cdc.MustUnmarshalJSON(res, &collections)
+			return cliCtx.PrintOutput(collections)
+		},
+	}
+	return flags.GetCommands(cmd)[0]
+}
+
+func GetCmdQueryCollectionNFTs(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "collection-nfts [id]",
+		Short: "Get nfts minted into the collection",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, types.QueryCollectionNFTs, args[0]), nil)
+			if err != nil {
+				return err
+			}
+			
+			var nfts []types.BaseTweetNFT
+			cdc.MustUnmarshalJSON(res, &nfts)
+			return cliCtx.PrintOutput(nfts)
+		},
+	}
+	return flags.GetCommands(cmd)[0]
+}
+
+func GetCmdQueryEditions(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "editions [master-nft-id]",
+		Short: "Get editions minted out of the master nft",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, types.QueryEditions, args[0]), nil)
+			if err != nil {
+				return err
+			}
+			
+			var editions []types.BaseTweetNFT
+			cdc.MustUnmarshalJSON(res, &editions)
+			return cliCtx.PrintOutput(editions)
+		},
+	}
+	return flags.GetCommands(cmd)[0]
+}
+
+func GetCmdQueryNFTByContentHash(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nft-by-hash [content-hash]",
+		Short: "Get the nft minted for a sha256 digest or CID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, types.QueryNFTByContentHash, args[0]), nil)
+			if err != nil {
+				return err
+			}
+			
+			var nft types.BaseTweetNFT
+			cdc.MustUnmarshalJSON(res, &nft)
+			return cliCtx.PrintOutput(nft)
+		},
+	}
+	return flags.GetCommands(cmd)[0]
+}
+
+func GetCmdQueryHandleAttestation(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "handle-attestation [platform] [handle]",
+		Short: "Get the attestation of the handle on the platform",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s/%s", types.QuerierRoute, types.QueryAttestation, args[0], args[1]), nil)
+			if err != nil {
+				return err
+			}
+			
+			var attestation types.HandleAttestation
+			cdc.MustUnmarshalJSON(res, &attestation)
+			return cliCtx.PrintOutput(attestation)
+		},
+	}
+	return flags.GetCommands(cmd)[0]
+}
+
+func GetCmdQueryHandleAttestations(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "handle-attestations",
+		Short: "Get all handle attestations",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAttestations), nil)
+			if err != nil {
+				return err
+			}
+			
+			var attestations []types.HandleAttestation
+			cdc.MustUnmarshalJSON(res, &attestations)
+			return cliCtx.PrintOutput(attestations)
+		},
+	}
+	return flags.GetCommands(cmd)[0]
+}
+
+func GetCmdQueryProfile(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "profile [platform] [handle]",
+		Short: "Get the creator profile of the handle on the platform",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s/%s", types.QuerierRoute, types.QueryProfile, args[0], args[1]), nil)
+			if err != nil {
+				return err
+			}
+			
+			var profile types.Profile
+			cdc.MustUnmarshalJSON(res, &profile)
+			return cliCtx.PrintOutput(profile)
+		},
+	}
+	return flags.GetCommands(cmd)[0]
+}
+
+func GetCmdQueryProfiles(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "profiles",
+		Short: "Get all creator profiles",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryProfiles), nil)
+			if err != nil {
+				return err
+			}
+			
+			var profiles []types.Profile
+			cdc.MustUnmarshalJSON(res, &profiles)
+			return cliCtx.PrintOutput(profiles)
+		},
+	}
+	return flags.GetCommands(cmd)[0]
+}
+
+func GetCmdQueryProfileNFTs(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "profile-nfts [platform] [handle]",
+		Short: "Get nfts minted under the handle on the platform",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s/%s", types.QuerierRoute, types.QueryProfileNFTs, args[0], args[1]), nil)
+			if err != nil {
+				return err
+			}
+			
+			var nfts []types.BaseTweetNFT
+			cdc.MustUnmarshalJSON(res, &nfts)
+			return cliCtx.PrintOutput(nfts)
+		},
+	}
+	return flags.GetCommands(cmd)[0]
+}
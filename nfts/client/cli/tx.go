@@ -2,7 +2,18 @@ package cli
 
 import (
 	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"strconv"
+	"strings"
 	
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -25,6 +36,30 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 	
 	NFTTxCmd.AddCommand(flags.PostCommands(
 		GetMsgMintTweetNFT(cdc),
+		GetMsgBatchMintTweetNFTs(cdc),
+		GetMsgUpdateLicenseCap(cdc),
+		GetMsgListTweetNFT(cdc),
+		GetMsgDelistTweetNFT(cdc),
+		GetMsgBuyTweetNFT(cdc),
+		GetMsgTransferTweetNFT(cdc),
+		GetMsgCreateAuction(cdc),
+		GetMsgPlaceBid(cdc),
+		GetMsgCancelAuction(cdc),
+		GetMsgUpdateRevenueSplits(cdc),
+		GetMsgSetCoOwners(cdc),
+		GetMsgSubmitProposal(cdc),
+		GetMsgApproveProposal(cdc),
+		GetMsgApproveNFT(cdc),
+		GetMsgRevokeNFTApproval(cdc),
+		GetMsgSetOperator(cdc),
+		GetMsgCreateBundle(cdc),
+		GetMsgUpdateNFTMetadata(cdc),
+		GetMsgDeleteBundle(cdc),
+		GetMsgCreateCollection(cdc),
+		GetMsgMintEdition(cdc),
+		GetMsgAttestHandle(cdc),
+		GetMsgRevokeHandleAttestation(cdc),
+		GetMsgSetProfile(cdc),
 	)...)
 	
 	return NFTTxCmd
@@ -66,7 +101,32 @@ func GetMsgMintTweetNFT(cdc *codec.Codec) *cobra.Command {
 				
 			}
 			
-			msg := types.NewMsgMintNFT(cliCtx.GetFromAddress(), viper.GetString(FlagAssetID), license, fee, share, viper.GetString(FlagTwitterHandle))
+			royaltyRate, err := sdk.NewDecFromStr(viper.GetString(FlagRoyaltyRate))
+			if err != nil {
+				return err
+			}
+			
+			splits, err := types.ParseRevenueSplits(viper.GetString(FlagRevenueSplits))
+			if err != nil {
+				return err
+			}
+			
+			metadata, err := metadataFromFlags()
+			if err != nil {
+				return err
+			}
+			
+			var terms *types.LicenseTerms
+			if license {
+				licenseTerms := types.NewLicenseTerms(viper.GetBool(FlagExclusive), viper.GetInt64(FlagDurationBlocks),
+					viper.GetInt64(FlagDurationSeconds), viper.GetStringSlice(FlagPermittedChannels),
+					viper.GetBool(FlagSublicensing), viper.GetBool(FlagCommercialUse), viper.GetInt64(FlagSubscriptionPeriod))
+				terms = &licenseTerms
+			}
+			
+			msg := types.NewMsgMintNFT(cliCtx.GetFromAddress(), viper.GetString(FlagCollection), viper.GetString(FlagAssetID), terms, fee, share,
+				viper.GetUint64(FlagMaxLicensees), viper.GetUint64(FlagMaxEditions), royaltyRate, splits, viper.GetString(FlagPlatform), viper.GetString(FlagTwitterHandle),
+				metadata)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -75,9 +135,892 @@ func GetMsgMintTweetNFT(cdc *codec.Codec) *cobra.Command {
 	}
 	
 	cmd.Flags().String(FlagAssetID, "", "AssetID")
-	cmd.Flags().String(FlagTwitterHandle, "", "Twitter handle")
+	cmd.Flags().String(FlagCollection, "", "Collection to mint the nft into, empty for a tweet nft")
+	cmd.Flags().String(FlagTwitterHandle, "", "Handle of the author on the platform")
+	cmd.Flags().String(FlagPlatform, types.DefaultPlatform, "Platform of the post, one of twitter, youtube, instagram or generic")
 	cmd.Flags().String(FlagLicenceFee, "0coco", "Twitter handle")
 	cmd.Flags().String(FlagRevenueShare, "0", "Revenue share")
 	cmd.Flags().String(FlagLicence, "false", "license")
+	cmd.Flags().Uint64(FlagMaxLicensees, 0, "Maximum number of concurrent licensees, 0 for unlimited")
+	cmd.Flags().Uint64(FlagMaxEditions, 0, "Mint a master of a limited edition with the given supply, 0 for a single nft")
+	cmd.Flags().String(FlagRoyaltyRate, "0", "Share of every resale paid to the creator")
+	cmd.Flags().String(FlagRevenueSplits, "", "Collaborators sharing the revenue as address:weight,address:weight")
+	cmd.Flags().Bool(FlagExclusive, false, "Grant license to a single licensee only")
+	cmd.Flags().Int64(FlagDurationBlocks, 0, "License duration in blocks, 0 for perpetual")
+	cmd.Flags().Int64(FlagDurationSeconds, 0, "License duration in seconds, 0 for perpetual")
+	cmd.Flags().StringSlice(FlagPermittedChannels, []string{}, "Channels the nft can be licensed over, empty for any")
+	cmd.Flags().Bool(FlagSublicensing, false, "Allow licensees to grant sublicenses")
+	cmd.Flags().Bool(FlagCommercialUse, false, "Allow commercial use of licensed content")
+	cmd.Flags().Int64(FlagSubscriptionPeriod, 0, "Charge the licensing fee once every given number of blocks, 0 for one-off fee")
+	addMetadataFlags(cmd)
+	return cmd
+}
+
+func GetMsgUpdateNFTMetadata(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-metadata [primary-nft-id]",
+		Short: "replace the metadata of an owned nft, the content hash it was minted with must be passed unchanged",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+			
+			metadata, err := metadataFromFlags()
+			if err != nil {
+				return err
+			}
+			
+			msg := types.NewMsgUpdateNFTMetadata(cliCtx.GetFromAddress(), args[0], metadata)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	
+	addMetadataFlags(cmd)
+	return cmd
+}
+
+func addMetadataFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagTitle, "", "Title of the media")
+	cmd.Flags().String(FlagDescription, "", "Description of the media")
+	cmd.Flags().String(FlagMediaType, "", "Media type, one of video, audio, image or text")
+	cmd.Flags().Uint64(FlagDuration, 0, "Duration of video or audio in seconds")
+	cmd.Flags().String(FlagContentURI, "", "URI the content is served from")
+	cmd.Flags().String(FlagContentHash, "", "Hex sha256 digest or CID of the content, can not be changed after mint")
+	cmd.Flags().String(FlagHashAlgo, "", "Algorithm of the content hash, one of sha256, cidv0 or cidv1, detected when empty")
+	cmd.Flags().String(FlagContentFile, "", "Local file to fill the content hash with its sha256 digest")
+	cmd.Flags().String(FlagLanguage, "", "ISO 639 language code of the content")
+	cmd.Flags().StringSlice(FlagTags, []string{}, "Comma separated tags")
+}
+
+func metadataFromFlags() (types.Metadata, error) {
+	hash, algorithm := viper.GetString(FlagContentHash), viper.GetString(FlagHashAlgo)
+	if path := viper.GetString(FlagContentFile); path != "" {
+		if hash != "" {
+			return types.Metadata{}, fmt.Errorf("either --%s or --%s can be given", FlagContentHash, FlagContentFile)
+		}
+		
+		fileHash, err := hashFile(path)
+		if err != nil {
+			return types.Metadata{}, err
+		}
+		hash, algorithm = fileHash, types.HashAlgorithmSHA256
+	}
+	
+	return types.NewMetadata(viper.GetString(FlagTitle), viper.GetString(FlagDescription), viper.GetString(FlagMediaType),
+		viper.GetUint64(FlagDuration), viper.GetString(FlagContentURI), hash, algorithm,
+		viper.GetString(FlagLanguage), viper.GetStringSlice(FlagTags)), nil
+}
+
+// hashFile returns the hex sha256 digest of the file, CIDs depend on how the content was
+// added to IPFS and have to be passed with --content-hash.
+func hashFile(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+	
+	hasher := sha256.New()
+	if _, err := io.Copy(hasher, file); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hasher.Sum(nil)), nil
+}
+
+func GetMsgUpdateLicenseCap(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-license-cap [primary-nft-id] [max-licensees]",
+		Short: "update maximum number of concurrent licensees of nft, 0 for unlimited",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+			
+			maxLicensees, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			
+			msg := types.NewMsgUpdateLicenseCap(cliCtx.GetFromAddress(), args[0], maxLicensees)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+func GetMsgListTweetNFT(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-nft [primary-nft-id] [price]",
+		Short: "list primary nft for sale, nft is held by the module until bought or delisted",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+			
+			price, err := sdk.ParseCoin(args[1])
+			if err != nil {
+				return err
+			}
+			
+			msg := types.NewMsgListTweetNFT(cliCtx.GetFromAddress(), args[0], price)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+func GetMsgDelistTweetNFT(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delist-nft [primary-nft-id]",
+		Short: "withdraw listed nft from sale",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+			
+			msg := types.NewMsgDelistTweetNFT(cliCtx.GetFromAddress(), args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+func GetMsgBuyTweetNFT(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "buy-nft [primary-nft-id] [price]",
+		Short: "buy listed nft at its listing price",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+			
+			price, err := sdk.ParseCoin(args[1])
+			if err != nil {
+				return err
+			}
+			
+			msg := types.NewMsgBuyTweetNFT(cliCtx.GetFromAddress(), args[0], price)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+func GetMsgTransferTweetNFT(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-nft [primary-nft-id] [recipient]",
+		Short: "transfer owned or approved nft to recipient",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+			
+			recipient, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+			
+			msg := types.NewMsgTransferTweetNFT(cliCtx.GetFromAddress(), args[0], recipient)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+func GetMsgCreateAuction(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-auction [primary-nft-id] [ownership|license] [english|dutch] [start-price] [duration-seconds]",
+		Short: "auction nft ownership or an exclusive license of it",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+			
+			startPrice, err := sdk.ParseCoin(args[3])
+			if err != nil {
+				return err
+			}
+			
+			duration, err := strconv.ParseInt(args[4], 10, 64)
+			if err != nil {
+				return err
+			}
+			
+			var floorPrice sdk.Coin
+			if floorStr := viper.GetString(FlagFloorPrice); floorStr != "" {
+				floorPrice, err = sdk.ParseCoin(floorStr)
+				if err != nil {
+					return err
+				}
+			}
+			
+			msg := types.NewMsgCreateAuction(cliCtx.GetFromAddress(), args[0], args[1], args[2], startPrice, floorPrice,
+				duration, viper.GetString(FlagChannel))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	
+	cmd.Flags().String(FlagFloorPrice, "", "Lowest price of a dutch auction")
+	cmd.Flags().String(FlagChannel, "", "Channel the auctioned license is granted over")
+	return cmd
+}
+
+func GetMsgPlaceBid(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "place-bid [auction-id] [amount]",
+		Short: "bid on an auction, the bid is escrowed until outbid or settled",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+			
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			
+			amount, err := sdk.ParseCoin(args[1])
+			if err != nil {
+				return err
+			}
+			
+			msg := types.NewMsgPlaceBid(cliCtx.GetFromAddress(), id, amount, viper.GetString(FlagLicensee))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	
+	cmd.Flags().String(FlagLicensee, "", "Licensee chain address receiving an auctioned license")
+	return cmd
+}
+
+func GetMsgCancelAuction(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-auction [auction-id]",
+		Short: "cancel an auction that has no bids",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+			
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			
+			msg := types.NewMsgCancelAuction(cliCtx.GetFromAddress(), id)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+func GetMsgUpdateRevenueSplits(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-revenue-splits [primary-nft-id] [address:weight,...]",
+		Short: "update the collaborators sharing the revenue of nft, empty splits pay the owner alone",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+			
+			splits, err := types.ParseRevenueSplits(args[1])
+			if err != nil {
+				return err
+			}
+			
+			msg := types.NewMsgUpdateRevenueSplits(cliCtx.GetFromAddress(), args[0], splits)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+func GetMsgSetCoOwners(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-co-owners [primary-nft-id] [threshold] [co-owner-address...]",
+		Short: "hand nft over to co-owners, actions on it then need threshold approvals",
+		Args:  cobra.MinimumNArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+			
+			threshold, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			
+			var coOwners []sdk.AccAddress
+			for _, arg := range args[2:] {
+				coOwner, err := sdk.AccAddressFromBech32(arg)
+				if err != nil {
+					return err
+				}
+				coOwners = append(coOwners, coOwner)
+			}
+			
+			msg := types.NewMsgSetCoOwners(cliCtx.GetFromAddress(), args[0], coOwners, threshold)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
 	return cmd
 }
+
+func GetMsgSubmitProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-proposal [primary-nft-id] [license|transfer|update_terms|burn]",
+		Short: "propose an action on co-owned nft",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+			
+			var fee sdk.Coin
+			var share sdk.Dec
+			var terms *types.LicenseTerms
+			if viper.GetBool(FlagLicence) {
+				var err error
+				fee, err = sdk.ParseCoin(viper.GetString(FlagLicenceFee))
+				if err != nil {
+					return err
+				}
+				
+				share, err = sdk.NewDecFromStr(viper.GetString(FlagRevenueShare))
+				if err != nil {
+					return err
+				}
+				
+				licenseTerms := types.NewLicenseTerms(viper.GetBool(FlagExclusive), viper.GetInt64(FlagDurationBlocks),
+					viper.GetInt64(FlagDurationSeconds), viper.GetStringSlice(FlagPermittedChannels),
+					viper.GetBool(FlagSublicensing), viper.GetBool(FlagCommercialUse), viper.GetInt64(FlagSubscriptionPeriod))
+				terms = &licenseTerms
+			}
+			
+			msg := types.NewMsgSubmitProposal(cliCtx.GetFromAddress(), args[0], args[1], viper.GetString(FlagRecipient),
+				viper.GetString(FlagChannel), terms, fee, share, viper.GetUint64(FlagMaxLicensees))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	
+	cmd.Flags().String(FlagRecipient, "", "Licensee of a license proposal or new owner of a transfer proposal")
+	cmd.Flags().String(FlagChannel, "", "Channel of a license proposal")
+	cmd.Flags().Bool(FlagLicence, false, "Make nft licensable in an update_terms proposal")
+	cmd.Flags().String(FlagLicenceFee, "", "Licensing fee of an update_terms proposal")
+	cmd.Flags().String(FlagRevenueShare, "", "Revenue share of an update_terms proposal")
+	cmd.Flags().Uint64(FlagMaxLicensees, 0, "Maximum number of concurrent licensees, 0 for unlimited")
+	cmd.Flags().Bool(FlagExclusive, false, "Grant license to a single licensee only")
+	cmd.Flags().Int64(FlagDurationBlocks, 0, "License duration in blocks, 0 for perpetual")
+	cmd.Flags().Int64(FlagDurationSeconds, 0, "License duration in seconds, 0 for perpetual")
+	cmd.Flags().StringSlice(FlagPermittedChannels, []string{}, "Channels the nft can be licensed over, empty for any")
+	cmd.Flags().Bool(FlagSublicensing, false, "Allow licensees to grant sublicenses")
+	cmd.Flags().Bool(FlagCommercialUse, false, "Allow commercial use of licensed content")
+	cmd.Flags().Int64(FlagSubscriptionPeriod, 0, "Charge the licensing fee once every given number of blocks, 0 for one-off fee")
+	return cmd
+}
+
+func GetMsgApproveProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve-proposal [proposal-id]",
+		Short: "approve a proposal on co-owned nft",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+			
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			
+			msg := types.NewMsgApproveProposal(cliCtx.GetFromAddress(), id)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+func GetMsgApproveNFT(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve-nft [primary-nft-id] [address]",
+		Short: "approve an address to license and transfer the nft on behalf of the owner",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+			
+			approved, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+			
+			msg := types.NewMsgApproveNFT(cliCtx.GetFromAddress(), args[0], approved)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+func GetMsgRevokeNFTApproval(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-nft-approval [primary-nft-id]",
+		Short: "revoke the approval of nft",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+			
+			msg := types.NewMsgRevokeNFTApproval(cliCtx.GetFromAddress(), args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+func GetMsgSetOperator(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-operator [operator-address] [true|false]",
+		Short: "grant or revoke an operator managing all nfts of the sender",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+			
+			operator, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			
+			approved, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+			
+			msg := types.NewMsgSetOperator(cliCtx.GetFromAddress(), operator, approved)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+func GetMsgCreateBundle(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-bundle [name] [members] [price] [revenue-share]",
+		Short: "bundle nfts as primary-nft-id:weight,primary-nft-id:weight licensed together for the price",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+			
+			members, err := types.ParseBundleMembers(args[1])
+			if err != nil {
+				return err
+			}
+			
+			price, err := sdk.ParseCoin(args[2])
+			if err != nil {
+				return err
+			}
+			
+			share, err := sdk.NewDecFromStr(args[3])
+			if err != nil {
+				return err
+			}
+			
+			terms := types.NewLicenseTerms(viper.GetBool(FlagExclusive), viper.GetInt64(FlagDurationBlocks),
+				viper.GetInt64(FlagDurationSeconds), viper.GetStringSlice(FlagPermittedChannels),
+				viper.GetBool(FlagSublicensing), viper.GetBool(FlagCommercialUse), viper.GetInt64(FlagSubscriptionPeriod))
+			
+			msg := types.NewMsgCreateBundle(cliCtx.GetFromAddress(), args[0], members, price, terms, share)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	
+	cmd.Flags().Bool(FlagExclusive, false, "Grant license to a single licensee only")
+	cmd.Flags().Int64(FlagDurationBlocks, 0, "License duration in blocks, 0 for perpetual")
+	cmd.Flags().Int64(FlagDurationSeconds, 0, "License duration in seconds, 0 for perpetual")
+	cmd.Flags().StringSlice(FlagPermittedChannels, []string{}, "Channels the bundle can be licensed over, empty for any")
+	cmd.Flags().Bool(FlagSublicensing, false, "Allow licensees to grant sublicenses")
+	cmd.Flags().Bool(FlagCommercialUse, false, "Allow commercial use of licensed content")
+	cmd.Flags().Int64(FlagSubscriptionPeriod, 0, "Charge the licensing fee once every given number of blocks, 0 for one-off fee")
+	return cmd
+}
+
+func GetMsgDeleteBundle(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete-bundle [bundle-id]",
+		Short: "delete an owned bundle",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+			
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			
+			msg := types.NewMsgDeleteBundle(cliCtx.GetFromAddress(), id)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+func GetMsgCreateCollection(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-collection [id] [name]",
+		Short: "create a collection whose nfts get ids prefixed with the collection id",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+			
+			msg := types.NewMsgCreateCollection(cliCtx.GetFromAddress(), args[0], args[1], viper.GetString(FlagDescription),
+				viper.GetString(FlagSchema), viper.GetString(FlagMintPermission), viper.GetStringSlice(FlagMinters))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	
+	cmd.Flags().String(FlagDescription, "", "Description of the collection")
+	cmd.Flags().String(FlagSchema, "", "JSON schema the metadata of the collection nfts follows")
+	cmd.Flags().String(FlagMintPermission, types.MintPermissionCreator, "Who can mint into the collection, one of creator, allowlist or anyone")
+	cmd.Flags().StringSlice(FlagMinters, []string{}, "Addresses allowed to mint with the allowlist permission")
+	return cmd
+}
+
+func GetMsgMintEdition(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint-edition [master-nft-id]",
+		Short: "mint the next numbered edition of a master nft",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+			
+			recipient := cliCtx.GetFromAddress()
+			if recipientStr := viper.GetString(FlagRecipient); recipientStr != "" {
+				addr, err := sdk.AccAddressFromBech32(recipientStr)
+				if err != nil {
+					return err
+				}
+				recipient = addr
+			}
+			
+			msg := types.NewMsgMintEdition(cliCtx.GetFromAddress(), args[0], recipient)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	
+	cmd.Flags().String(FlagRecipient, "", "Owner of the edition, the sender when empty")
+	return cmd
+}
+
+func GetMsgAttestHandle(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "attest-handle [platform] [handle] [address]",
+		Short: "attest as a verifier that the handle on the platform is owned by the address",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+			
+			addr, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return err
+			}
+			
+			msg := types.NewMsgAttestHandle(cliCtx.GetFromAddress(), args[0], args[1], addr, viper.GetInt64(FlagExpirySeconds))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	
+	cmd.Flags().Int64(FlagExpirySeconds, 0, "Seconds the attestation holds, 0 until revoked")
+	return cmd
+}
+
+func GetMsgRevokeHandleAttestation(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-attestation [platform] [handle]",
+		Short: "revoke the attestation of the handle on the platform as a verifier",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+			
+			msg := types.NewMsgRevokeHandleAttestation(cliCtx.GetFromAddress(), args[0], args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+func GetMsgSetProfile(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-profile [platform] [handle]",
+		Short: "create or update the creator profile of the handle on the platform",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+			
+			msg := types.NewMsgSetProfile(cliCtx.GetFromAddress(), args[0], args[1], viper.GetString(FlagDisplayName),
+				viper.GetString(FlagAvatarURI), viper.GetString(FlagPayoutAddress))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	
+	cmd.Flags().String(FlagDisplayName, "", "Display name of the creator")
+	cmd.Flags().String(FlagAvatarURI, "", "URI of the avatar of the creator")
+	cmd.Flags().String(FlagPayoutAddress, "", "Address the creator wants to be paid at, the sender when empty")
+	return cmd
+}
+
+func GetMsgBatchMintTweetNFTs(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-mint-nfts [file]",
+		Short: "mint many tweet nfts read from a json or csv file",
+		Long: `Mint many tweet nfts in a single transaction. A .json file holds an array of entries with the
+fields of mint-nft, a .csv file has a header row naming the columns:
+
+asset_id,twitter_handle,licensing_fee,revenue_share,max_licensees,royalty_rate,revenue_splits,
+exclusive,duration_blocks,duration_seconds,permitted_channels,sublicensing_allowed,commercial_use,
+subscription_period_blocks,title,description,media_type,duration,content_uri,content_hash,language,
+tags,platform,collection_id,max_editions,hash_algorithm
+
+An entry of the csv file is licensable when it has a licensing fee, its permitted channels and
+tags are separated by semicolons.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+			
+			entries, err := parseMintEntries(args[0])
+			if err != nil {
+				return err
+			}
+			
+			msg := types.NewMsgBatchMintTweetNFTs(cliCtx.GetFromAddress(), entries)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+func parseMintEntries(path string) ([]types.MintEntry, error) {
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		var entries []types.MintEntry
+		if err := json.Unmarshal(bz, &entries); err != nil {
+			return nil, err
+		}
+		return entries, nil
+	case ".csv":
+		return parseMintEntriesCSV(bz)
+	default:
+		return nil, fmt.Errorf("unsupported file %s, expected a .json or .csv file", path)
+	}
+}
+
+func parseMintEntriesCSV(bz []byte) ([]types.MintEntry, error) {
+	records, err := csv.NewReader(bytes.NewReader(bz)).ReadAll()
+	if err != nil {
+		return nil, err
+	} else if len(records) < 2 {
+		return nil, fmt.Errorf("csv file should have a header row and at least one entry")
+	}
+	
+	columns := make(map[string]int)
+	for i, name := range records[0] {
+		columns[strings.TrimSpace(name)] = i
+	}
+	
+	entries := make([]types.MintEntry, 0, len(records)-1)
+	for i, record := range records[1:] {
+		field := func(name string) string {
+			if column, ok := columns[name]; ok {
+				return strings.TrimSpace(record[column])
+			}
+			return ""
+		}
+		
+		entry, err := parseMintEntryCSV(field)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s", i+2, err.Error())
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+func parseMintEntryCSV(field func(name string) string) (types.MintEntry, error) {
+	entry := types.MintEntry{
+		CollectionID:  field("collection_id"),
+		AssetID:       field("asset_id"),
+		TwitterHandle: field("twitter_handle"),
+		Platform:      types.NormalizePlatform(field("platform")),
+	}
+	
+	var err error
+	if entry.RoyaltyRate, err = sdk.NewDecFromStr(orDefault(field("royalty_rate"), "0")); err != nil {
+		return entry, err
+	}
+	if entry.MaxLicensees, err = strconv.ParseUint(orDefault(field("max_licensees"), "0"), 10, 64); err != nil {
+		return entry, err
+	}
+	if entry.MaxEditions, err = strconv.ParseUint(orDefault(field("max_editions"), "0"), 10, 64); err != nil {
+		return entry, err
+	}
+	if entry.RevenueSplits, err = types.ParseRevenueSplits(field("revenue_splits")); err != nil {
+		return entry, err
+	}
+	
+	duration, err := strconv.ParseUint(orDefault(field("duration"), "0"), 10, 64)
+	if err != nil {
+		return entry, err
+	}
+	entry.Metadata = types.NewMetadata(field("title"), field("description"), field("media_type"), duration,
+		field("content_uri"), field("content_hash"), field("hash_algorithm"), field("language"), strings.Split(field("tags"), ";"))
+	
+	if field("licensing_fee") == "" {
+		return entry, nil
+	}
+	if entry.LicensingFee, err = sdk.ParseCoin(field("licensing_fee")); err != nil {
+		return entry, err
+	}
+	if entry.RevenueShare, err = sdk.NewDecFromStr(orDefault(field("revenue_share"), "0")); err != nil {
+		return entry, err
+	}
+	
+	var terms types.LicenseTerms
+	if terms.Exclusive, err = strconv.ParseBool(orDefault(field("exclusive"), "false")); err != nil {
+		return entry, err
+	}
+	if terms.DurationBlocks, err = strconv.ParseInt(orDefault(field("duration_blocks"), "0"), 10, 64); err != nil {
+		return entry, err
+	}
+	if terms.DurationSeconds, err = strconv.ParseInt(orDefault(field("duration_seconds"), "0"), 10, 64); err != nil {
+		return entry, err
+	}
+	if channels := field("permitted_channels"); channels != "" {
+		terms.PermittedChannels = strings.Split(channels, ";")
+	}
+	if terms.SublicensingAllowed, err = strconv.ParseBool(orDefault(field("sublicensing_allowed"), "false")); err != nil {
+		return entry, err
+	}
+	if terms.CommercialUse, err = strconv.ParseBool(orDefault(field("commercial_use"), "false")); err != nil {
+		return entry, err
+	}
+	if terms.SubscriptionPeriodBlocks, err = strconv.ParseInt(orDefault(field("subscription_period_blocks"), "0"), 10, 64); err != nil {
+		return entry, err
+	}
+	
+	entry.LicenseTerms = &terms
+	return entry, nil
+}
+
+func orDefault(value, defaultValue string) string {
+	if value == "" {
+		return defaultValue
+	}
+	return value
+}
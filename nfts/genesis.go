@@ -5,6 +5,13 @@ import (
 )
 
 func InitGenesis(ctx sdk.Context, k Keeper, genState GenesisState) {
+	k.SetParams(ctx, genState.Params)
+	
+	// collections are mirrored on CoCo, so both chains hold them
+	for _, collection := range genState.Collections {
+		k.SetCollection(ctx, collection)
+	}
+	
 	if GetContextOfCurrentChain() == CoCoContext {
 		for _, nft := range genState.TweetNFTs {
 			count := k.GetGlobalTweetCount(ctx)
@@ -22,14 +29,104 @@ func InitGenesis(ctx sdk.Context, k Keeper, genState GenesisState) {
 			k.MintTweetNFT(ctx, nft)
 			k.SetTweetIDToAccount(ctx, addr, nft.PrimaryNFTID)
 			k.SetGlobalTweetCount(ctx, count+1)
+			if nft.Metadata.ContentHash != "" && !nft.IsEdition() {
+				k.SetContentHashNFT(ctx, nft.Metadata.ContentHash, nft.PrimaryNFTID)
+			}
+		}
+		
+		for _, grant := range genState.LicenseGrants {
+			k.SetLicenseGrant(ctx, grant)
+		}
+		
+		for _, listing := range genState.Listings {
+			k.SetListing(ctx, listing)
+			seller, _ := sdk.AccAddressFromBech32(listing.Seller)
+			k.SetEscrow(ctx, listing.PrimaryNFTID, seller)
+		}
+		
+		for _, auction := range genState.Auctions {
+			k.SetAuction(ctx, auction)
+			if auction.IsActive() {
+				k.InsertAuctionQueue(ctx, auction)
+			}
+			
+			if auction.Kind == AuctionKindOwnership {
+				seller, _ := sdk.AccAddressFromBech32(auction.Seller)
+				k.SetEscrow(ctx, auction.PrimaryNFTID, seller)
+			}
+		}
+		k.SetAuctionCount(ctx, genState.AuctionCount)
+		
+		for _, proposal := range genState.Proposals {
+			k.SetProposal(ctx, proposal)
+			k.InsertProposalQueue(ctx, proposal)
+		}
+		k.SetProposalCount(ctx, genState.ProposalCount)
+		
+		for _, approval := range genState.LicenseApprovals {
+			k.SetLicenseApproval(ctx, approval)
+		}
+		
+		for _, approval := range genState.NFTApprovals {
+			k.SetNFTApproval(ctx, approval)
 		}
+		
+		for _, operator := range genState.Operators {
+			k.SetOperator(ctx, operator)
+		}
+		
+		for _, bundle := range genState.Bundles {
+			k.SetBundle(ctx, bundle)
+		}
+		k.SetBundleCount(ctx, genState.BundleCount)
+		
+		for _, attestation := range genState.Attestations {
+			k.SetHandleAttestation(ctx, attestation)
+		}
+		
+		for _, profile := range genState.Profiles {
+			k.SetProfile(ctx, profile)
+		}
+	}
+	
+	// burned nfts keep their ids, the count can be ahead of the nfts in the genesis
+	if genState.GlobalTweetCount > k.GetGlobalTweetCount(ctx) {
+		k.SetGlobalTweetCount(ctx, genState.GlobalTweetCount)
+	}
+	
+	// genesis exported before platforms were introduced only holds tweets
+	k.MigratePlatforms(ctx)
+	// and flags licensable nfts instead of giving them license terms
+	k.MigrateLicenses(ctx)
+	if GetContextOfCurrentChain() == FreeFlixContext && len(genState.Profiles) == 0 {
+		k.MigrateProfiles(ctx)
 	}
 }
 
 func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 	nfts := k.GetAllTweetNFTs(ctx)
+	grants := k.GetAllLicenseGrants(ctx)
+	listings := k.GetAllListings(ctx)
+	auctions := k.GetAllAuctions(ctx)
+	proposals := k.GetAllProposals(ctx)
 	
 	return GenesisState{
-		TweetNFTs: nfts,
+		Params:           k.GetParams(ctx),
+		TweetNFTs:        nfts,
+		LicenseGrants:    grants,
+		Listings:         listings,
+		Auctions:         auctions,
+		AuctionCount:     k.GetAuctionCount(ctx),
+		Proposals:        proposals,
+		ProposalCount:    k.GetProposalCount(ctx),
+		LicenseApprovals: k.GetAllLicenseApprovals(ctx),
+		NFTApprovals:     k.GetAllNFTApprovals(ctx),
+		Operators:        k.GetAllOperators(ctx),
+		Bundles:          k.GetAllBundles(ctx),
+		BundleCount:      k.GetBundleCount(ctx),
+		Collections:      k.GetAllCollections(ctx),
+		Attestations:     k.GetAllHandleAttestations(ctx),
+		Profiles:         k.GetAllProfiles(ctx),
+		GlobalTweetCount: k.GetGlobalTweetCount(ctx),
 	}
 }
@@ -1,6 +1,7 @@
 package nfts
 
 import (
+	"fmt"
 	"strings"
 	
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -14,6 +15,54 @@ func NewHandler(keeper Keeper) sdk.Handler {
 		switch msg := msg.(type) {
 		case MsgMintTweetNFT:
 			return handleMsgMintTweetNFT(ctx, keeper, msg)
+		case MsgBatchMintTweetNFTs:
+			return handleMsgBatchMintTweetNFTs(ctx, keeper, msg)
+		case MsgUpdateLicenseCap:
+			return handleMsgUpdateLicenseCap(ctx, keeper, msg)
+		case MsgUpdateNFTMetadata:
+			return handleMsgUpdateNFTMetadata(ctx, keeper, msg)
+		case MsgListTweetNFT:
+			return handleMsgListTweetNFT(ctx, keeper, msg)
+		case MsgDelistTweetNFT:
+			return handleMsgDelistTweetNFT(ctx, keeper, msg)
+		case MsgBuyTweetNFT:
+			return handleMsgBuyTweetNFT(ctx, keeper, msg)
+		case MsgTransferTweetNFT:
+			return handleMsgTransferTweetNFT(ctx, keeper, msg)
+		case MsgCreateAuction:
+			return handleMsgCreateAuction(ctx, keeper, msg)
+		case MsgPlaceBid:
+			return handleMsgPlaceBid(ctx, keeper, msg)
+		case MsgCancelAuction:
+			return handleMsgCancelAuction(ctx, keeper, msg)
+		case MsgUpdateRevenueSplits:
+			return handleMsgUpdateRevenueSplits(ctx, keeper, msg)
+		case MsgSetCoOwners:
+			return handleMsgSetCoOwners(ctx, keeper, msg)
+		case MsgSubmitProposal:
+			return handleMsgSubmitProposal(ctx, keeper, msg)
+		case MsgApproveProposal:
+			return handleMsgApproveProposal(ctx, keeper, msg)
+		case MsgApproveNFT:
+			return handleMsgApproveNFT(ctx, keeper, msg)
+		case MsgRevokeNFTApproval:
+			return handleMsgRevokeNFTApproval(ctx, keeper, msg)
+		case MsgSetOperator:
+			return handleMsgSetOperator(ctx, keeper, msg)
+		case MsgCreateBundle:
+			return handleMsgCreateBundle(ctx, keeper, msg)
+		case MsgDeleteBundle:
+			return handleMsgDeleteBundle(ctx, keeper, msg)
+		case MsgCreateCollection:
+			return handleMsgCreateCollection(ctx, keeper, msg)
+		case MsgMintEdition:
+			return handleMsgMintEdition(ctx, keeper, msg)
+		case MsgAttestHandle:
+			return handleMsgAttestHandle(ctx, keeper, msg)
+		case MsgRevokeHandleAttestation:
+			return handleMsgRevokeHandleAttestation(ctx, keeper, msg)
+		case MsgSetProfile:
+			return handleMsgSetProfile(ctx, keeper, msg)
 		
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized NFT message type: %T", msg)
@@ -22,32 +71,37 @@ func NewHandler(keeper Keeper) sdk.Handler {
 }
 
 func handleMsgMintTweetNFT(ctx sdk.Context, keeper Keeper, msg MsgMintTweetNFT) (*sdk.Result, error) {
+	royaltyRate, err := keeper.ValidateMint(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
 	
-	nfts := keeper.GetTweetsOfAccount(ctx, msg.Sender)
+	mintTweetNFT(ctx, keeper, msg, royaltyRate)
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
 	
-	for _, nft := range nfts {
-		if strings.EqualFold(nft.AssetID, msg.AssetID) {
-			return nil, sdkerrors.Wrap(ErrAssetIDAlreadyExist, "")
+}
+
+// handleMsgBatchMintTweetNFTs validates every entry before minting any, the nfts get
+// consecutive ids in the order of the entries.
+func handleMsgBatchMintTweetNFTs(ctx sdk.Context, keeper Keeper, msg MsgBatchMintTweetNFTs) (*sdk.Result, error) {
+	royaltyRates := make([]sdk.Dec, len(msg.Entries))
+	for i, entry := range msg.Entries {
+		royaltyRate, err := keeper.ValidateMint(ctx, entry.ToMsgMintTweetNFT(msg.Sender))
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "entry %d", i)
 		}
+		royaltyRates[i] = royaltyRate
 	}
 	
-	count := keeper.GetGlobalTweetCount(ctx)
-	id := GetPrimaryNFTID(count)
-	tweetNFT := BaseTweetNFT{
-		PrimaryNFTID:   id,
-		PrimaryOwner:   msg.Sender.String(),
-		SecondaryNFTID: "",
-		SecondaryOwner: "",
-		License:        msg.License,
-		AssetID:        msg.AssetID,
-		LicensingFee:   msg.LicensingFee,
-		RevenueShare:   msg.RevenueShare,
-		TwitterHandle:  msg.TwitterHandle,
+	for i, entry := range msg.Entries {
+		mintTweetNFT(ctx, keeper, entry.ToMsgMintTweetNFT(msg.Sender), royaltyRates[i])
 	}
 	
-	keeper.MintTweetNFT(ctx, tweetNFT)
-	keeper.SetTweetIDToAccount(ctx, msg.Sender, tweetNFT.PrimaryNFTID)
-	keeper.SetGlobalTweetCount(ctx, count+1)
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func mintTweetNFT(ctx sdk.Context, keeper Keeper, msg MsgMintTweetNFT, royaltyRate sdk.Dec) {
+	tweetNFT := keeper.MintNewTweetNFT(ctx, msg, royaltyRate)
 	
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -56,9 +110,472 @@ func handleMsgMintTweetNFT(ctx sdk.Context, keeper Keeper, msg MsgMintTweetNFT)
 			sdk.NewAttribute(AttributePrimaryNFTID, tweetNFT.PrimaryNFTID),
 			sdk.NewAttribute(AttributeAssetID, tweetNFT.AssetID),
 			sdk.NewAttribute(AttributeTwitterHandle, tweetNFT.TwitterHandle),
+			sdk.NewAttribute(AttributePlatform, tweetNFT.Platform),
+			sdk.NewAttribute(AttributeCollectionID, tweetNFT.CollectionID),
+			sdk.NewAttribute(AttributeMaxEditions, fmt.Sprintf("%d", tweetNFT.MaxEditions)),
+		),
+	)
+}
+
+func handleMsgUpdateLicenseCap(ctx sdk.Context, keeper Keeper, msg MsgUpdateLicenseCap) (*sdk.Result, error) {
+	nft, found := keeper.GetTweetNFTByID(ctx, msg.PrimaryNFTID)
+	if !found {
+		return nil, sdkerrors.Wrap(ErrNFTNotFound, msg.PrimaryNFTID)
+	}
+	
+	if nft.PrimaryOwner != msg.Sender.String() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only primary owner can update license cap")
+	}
+	
+	nft.MaxLicensees = msg.MaxLicensees
+	keeper.MintTweetNFT(ctx, nft)
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeMsgUpdateLicenseCap,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+			sdk.NewAttribute(AttributePrimaryNFTID, nft.PrimaryNFTID),
+			sdk.NewAttribute(AttributeMaxLicensees, fmt.Sprintf("%d", nft.MaxLicensees)),
+		),
+	)
+	
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func handleMsgUpdateNFTMetadata(ctx sdk.Context, keeper Keeper, msg MsgUpdateNFTMetadata) (*sdk.Result, error) {
+	nft, found := keeper.GetTweetNFTByID(ctx, msg.PrimaryNFTID)
+	if !found {
+		return nil, sdkerrors.Wrap(ErrNFTNotFound, msg.PrimaryNFTID)
+	}
+	
+	if nft.PrimaryOwner != msg.Sender.String() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only primary owner can update metadata")
+	} else if nft.Metadata.ContentHash != msg.Metadata.ContentHash {
+		return nil, sdkerrors.Wrap(ErrInvalidMetadata, "content hash can not be changed after mint")
+	}
+	
+	nft.Metadata = msg.Metadata
+	keeper.MintTweetNFT(ctx, nft)
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeMsgUpdateNFTMetadata,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+			sdk.NewAttribute(AttributePrimaryNFTID, nft.PrimaryNFTID),
+			sdk.NewAttribute(AttributeContentHash, nft.Metadata.ContentHash),
+		),
+	)
+	
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func handleMsgListTweetNFT(ctx sdk.Context, keeper Keeper, msg MsgListTweetNFT) (*sdk.Result, error) {
+	listing, err := keeper.ListTweetNFT(ctx, msg.Sender, msg.PrimaryNFTID, msg.Price)
+	if err != nil {
+		return nil, err
+	}
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeMsgListTweetNFT,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+			sdk.NewAttribute(AttributeSeller, listing.Seller),
+			sdk.NewAttribute(AttributePrimaryNFTID, msg.PrimaryNFTID),
+			sdk.NewAttribute(AttributePrice, msg.Price.String()),
+		),
+	)
+	
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func handleMsgDelistTweetNFT(ctx sdk.Context, keeper Keeper, msg MsgDelistTweetNFT) (*sdk.Result, error) {
+	listing, err := keeper.DelistTweetNFT(ctx, msg.Sender, msg.PrimaryNFTID)
+	if err != nil {
+		return nil, err
+	}
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeMsgDelistTweetNFT,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+			sdk.NewAttribute(AttributeSeller, listing.Seller),
+			sdk.NewAttribute(AttributePrimaryNFTID, msg.PrimaryNFTID),
 		),
 	)
 	
 	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func handleMsgBuyTweetNFT(ctx sdk.Context, keeper Keeper, msg MsgBuyTweetNFT) (*sdk.Result, error) {
+	listing, royalty, err := keeper.BuyTweetNFT(ctx, msg.Buyer, msg.PrimaryNFTID, msg.Price)
+	if err != nil {
+		return nil, err
+	}
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeMsgBuyTweetNFT,
+			sdk.NewAttribute(AttributeBuyer, msg.Buyer.String()),
+			sdk.NewAttribute(AttributeSeller, listing.Seller),
+			sdk.NewAttribute(AttributePrimaryNFTID, msg.PrimaryNFTID),
+			sdk.NewAttribute(AttributePrice, listing.Price.String()),
+			sdk.NewAttribute(AttributeRoyalty, royalty.String()),
+		),
+	)
+	
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func handleMsgTransferTweetNFT(ctx sdk.Context, keeper Keeper, msg MsgTransferTweetNFT) (*sdk.Result, error) {
+	owner, err := keeper.TransferOwnedTweetNFT(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeMsgTransferTweetNFT,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+			sdk.NewAttribute(AttributeSeller, owner.String()),
+			sdk.NewAttribute(AttributeRecipient, msg.Recipient.String()),
+			sdk.NewAttribute(AttributePrimaryNFTID, msg.PrimaryNFTID),
+		),
+	)
+	
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func handleMsgCreateAuction(ctx sdk.Context, keeper Keeper, msg MsgCreateAuction) (*sdk.Result, error) {
+	auction, err := keeper.CreateAuction(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeMsgCreateAuction,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+			sdk.NewAttribute(AttributeSeller, auction.Seller),
+			sdk.NewAttribute(AttributePrimaryNFTID, msg.PrimaryNFTID),
+			sdk.NewAttribute(AttributeAuctionID, fmt.Sprintf("%d", auction.ID)),
+			sdk.NewAttribute(AttributeAuctionKind, auction.Kind),
+			sdk.NewAttribute(AttributePrice, auction.StartPrice.String()),
+		),
+	)
+	
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func handleMsgPlaceBid(ctx sdk.Context, keeper Keeper, msg MsgPlaceBid) (*sdk.Result, error) {
+	auction, err := keeper.PlaceBid(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeMsgPlaceBid,
+			sdk.NewAttribute(AttributeBuyer, msg.Bidder.String()),
+			sdk.NewAttribute(AttributePrimaryNFTID, auction.PrimaryNFTID),
+			sdk.NewAttribute(AttributeAuctionID, fmt.Sprintf("%d", auction.ID)),
+			sdk.NewAttribute(AttributeBid, auction.HighestBid.String()),
+		),
+	)
+	
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func handleMsgCancelAuction(ctx sdk.Context, keeper Keeper, msg MsgCancelAuction) (*sdk.Result, error) {
+	auction, err := keeper.CancelAuction(ctx, msg.Sender, msg.AuctionID)
+	if err != nil {
+		return nil, err
+	}
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeMsgCancelAuction,
+			sdk.NewAttribute(AttributeSeller, msg.Sender.String()),
+			sdk.NewAttribute(AttributePrimaryNFTID, auction.PrimaryNFTID),
+			sdk.NewAttribute(AttributeAuctionID, fmt.Sprintf("%d", auction.ID)),
+		),
+	)
+	
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func handleMsgUpdateRevenueSplits(ctx sdk.Context, keeper Keeper, msg MsgUpdateRevenueSplits) (*sdk.Result, error) {
+	nft, found := keeper.GetTweetNFTByID(ctx, msg.PrimaryNFTID)
+	if !found {
+		return nil, sdkerrors.Wrap(ErrNFTNotFound, msg.PrimaryNFTID)
+	}
+	
+	if nft.PrimaryOwner != msg.Sender.String() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only primary owner can update revenue splits")
+	}
+	
+	nft.RevenueSplits = msg.RevenueSplits
+	keeper.MintTweetNFT(ctx, nft)
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeMsgUpdateRevenueSplits,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+			sdk.NewAttribute(AttributePrimaryNFTID, nft.PrimaryNFTID),
+			sdk.NewAttribute(AttributeRevenueSplits, fmt.Sprintf("%s", nft.RevenueSplits)),
+		),
+	)
+	
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func handleMsgSetCoOwners(ctx sdk.Context, keeper Keeper, msg MsgSetCoOwners) (*sdk.Result, error) {
+	nft, err := keeper.SetCoOwners(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeMsgSetCoOwners,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+			sdk.NewAttribute(AttributePrimaryNFTID, nft.PrimaryNFTID),
+			sdk.NewAttribute(AttributeCoOwners, strings.Join(nft.CoOwners, ",")),
+			sdk.NewAttribute(AttributeThreshold, fmt.Sprintf("%d", nft.Threshold)),
+		),
+	)
+	
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func handleMsgSubmitProposal(ctx sdk.Context, keeper Keeper, msg MsgSubmitProposal) (*sdk.Result, error) {
+	proposal, executed, err := keeper.SubmitProposal(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
 	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeMsgSubmitProposal,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+			sdk.NewAttribute(AttributePrimaryNFTID, proposal.PrimaryNFTID),
+			sdk.NewAttribute(AttributeProposalID, fmt.Sprintf("%d", proposal.ID)),
+			sdk.NewAttribute(AttributeAction, proposal.Action),
+		),
+	)
+	emitProposalExecuted(ctx, proposal, executed)
+	
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func handleMsgApproveProposal(ctx sdk.Context, keeper Keeper, msg MsgApproveProposal) (*sdk.Result, error) {
+	proposal, executed, err := keeper.ApproveProposal(ctx, msg.Sender, msg.ProposalID)
+	if err != nil {
+		return nil, err
+	}
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeMsgApproveProposal,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+			sdk.NewAttribute(AttributePrimaryNFTID, proposal.PrimaryNFTID),
+			sdk.NewAttribute(AttributeProposalID, fmt.Sprintf("%d", proposal.ID)),
+		),
+	)
+	emitProposalExecuted(ctx, proposal, executed)
+	
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func emitProposalExecuted(ctx sdk.Context, proposal Proposal, executed bool) {
+	if !executed {
+		return
+	}
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeProposalExecuted,
+			sdk.NewAttribute(AttributePrimaryNFTID, proposal.PrimaryNFTID),
+			sdk.NewAttribute(AttributeProposalID, fmt.Sprintf("%d", proposal.ID)),
+			sdk.NewAttribute(AttributeAction, proposal.Action),
+		),
+	)
+}
+
+func handleMsgApproveNFT(ctx sdk.Context, keeper Keeper, msg MsgApproveNFT) (*sdk.Result, error) {
+	if err := keeper.ApproveNFT(ctx, msg); err != nil {
+		return nil, err
+	}
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeMsgApproveNFT,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+			sdk.NewAttribute(AttributePrimaryNFTID, msg.PrimaryNFTID),
+			sdk.NewAttribute(AttributeApproved, msg.Approved.String()),
+		),
+	)
+	
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func handleMsgRevokeNFTApproval(ctx sdk.Context, keeper Keeper, msg MsgRevokeNFTApproval) (*sdk.Result, error) {
+	if err := keeper.RevokeNFTApproval(ctx, msg); err != nil {
+		return nil, err
+	}
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeMsgRevokeNFTApproval,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+			sdk.NewAttribute(AttributePrimaryNFTID, msg.PrimaryNFTID),
+		),
+	)
+	
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func handleMsgSetOperator(ctx sdk.Context, keeper Keeper, msg MsgSetOperator) (*sdk.Result, error) {
+	if msg.Approved {
+		keeper.SetOperator(ctx, NewOperatorApproval(msg.Sender.String(), msg.Operator.String()))
+	} else {
+		keeper.DeleteOperator(ctx, msg.Sender.String(), msg.Operator.String())
+	}
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeMsgSetOperator,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+			sdk.NewAttribute(AttributeOperator, msg.Operator.String()),
+			sdk.NewAttribute(AttributeApproved, fmt.Sprintf("%t", msg.Approved)),
+		),
+	)
+	
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func handleMsgCreateBundle(ctx sdk.Context, keeper Keeper, msg MsgCreateBundle) (*sdk.Result, error) {
+	bundle, err := keeper.CreateBundle(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeMsgCreateBundle,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+			sdk.NewAttribute(AttributeBundleID, fmt.Sprintf("%d", bundle.ID)),
+			sdk.NewAttribute(AttributePrice, bundle.Price.String()),
+		),
+	)
+	
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func handleMsgDeleteBundle(ctx sdk.Context, keeper Keeper, msg MsgDeleteBundle) (*sdk.Result, error) {
+	bundle, err := keeper.RemoveBundle(ctx, msg.Sender, msg.BundleID)
+	if err != nil {
+		return nil, err
+	}
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeMsgDeleteBundle,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+			sdk.NewAttribute(AttributeBundleID, fmt.Sprintf("%d", bundle.ID)),
+		),
+	)
+	
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func handleMsgCreateCollection(ctx sdk.Context, keeper Keeper, msg MsgCreateCollection) (*sdk.Result, error) {
+	collection, err := keeper.CreateCollection(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeMsgCreateCollection,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+			sdk.NewAttribute(AttributeCollectionID, collection.ID),
+		),
+	)
+	
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func handleMsgMintEdition(ctx sdk.Context, keeper Keeper, msg MsgMintEdition) (*sdk.Result, error) {
+	edition, err := keeper.MintEdition(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeMsgMintEdition,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+			sdk.NewAttribute(AttributeMasterNFTID, edition.MasterNFTID),
+			sdk.NewAttribute(AttributePrimaryNFTID, edition.PrimaryNFTID),
+			sdk.NewAttribute(AttributeEditionNumber, fmt.Sprintf("%d", edition.EditionNumber)),
+		),
+	)
+	
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func handleMsgAttestHandle(ctx sdk.Context, keeper Keeper, msg MsgAttestHandle) (*sdk.Result, error) {
+	if err := keeper.AttestHandle(ctx, msg); err != nil {
+		return nil, err
+	}
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeMsgAttestHandle,
+			sdk.NewAttribute(AttributeVerifier, msg.Verifier.String()),
+			sdk.NewAttribute(AttributePlatform, msg.Platform),
+			sdk.NewAttribute(AttributeTwitterHandle, msg.Handle),
+			sdk.NewAttribute(AttributeAttested, msg.Address.String()),
+		),
+	)
+	
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func handleMsgRevokeHandleAttestation(ctx sdk.Context, keeper Keeper, msg MsgRevokeHandleAttestation) (*sdk.Result, error) {
+	attestation, err := keeper.RevokeHandleAttestation(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeMsgRevokeAttestation,
+			sdk.NewAttribute(AttributeVerifier, msg.Verifier.String()),
+			sdk.NewAttribute(AttributePlatform, attestation.Platform),
+			sdk.NewAttribute(AttributeTwitterHandle, attestation.Handle),
+			sdk.NewAttribute(AttributeAttested, attestation.Address),
+		),
+	)
+	
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func handleMsgSetProfile(ctx sdk.Context, keeper Keeper, msg MsgSetProfile) (*sdk.Result, error) {
+	profile, err := keeper.UpdateProfile(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeMsgSetProfile,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+			sdk.NewAttribute(AttributePlatform, profile.Platform),
+			sdk.NewAttribute(AttributeTwitterHandle, profile.Handle),
+			sdk.NewAttribute(AttributeVerified, fmt.Sprintf("%t", profile.Verified)),
+		),
+	)
+	
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
 }
@@ -0,0 +1,132 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	
+	"github.com/FreeFlixMedia/modules/nfts/internal/types"
+)
+
+func (keeper Keeper) SetNFTApproval(ctx sdk.Context, approval types.NFTApproval) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.GetNFTApprovalKey(approval.PrimaryNFTID), keeper.cdc.MustMarshalBinaryLengthPrefixed(approval))
+}
+
+func (keeper Keeper) GetNFTApproval(ctx sdk.Context, primaryNFTID string) (types.NFTApproval, bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	
+	bz := store.Get(types.GetNFTApprovalKey(primaryNFTID))
+	if bz == nil {
+		return types.NFTApproval{}, false
+	}
+	
+	var approval types.NFTApproval
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &approval)
+	return approval, true
+}
+
+func (keeper Keeper) DeleteNFTApproval(ctx sdk.Context, primaryNFTID string) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.GetNFTApprovalKey(primaryNFTID))
+}
+
+func (keeper Keeper) GetAllNFTApprovals(ctx sdk.Context) []types.NFTApproval {
+	store := ctx.KVStore(keeper.storeKey)
+	
+	iterator := sdk.KVStorePrefixIterator(store, types.NFTApprovalPrefix)
+	defer iterator.Close()
+	
+	var approvals []types.NFTApproval
+	for ; iterator.Valid(); iterator.Next() {
+		var approval types.NFTApproval
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &approval)
+		approvals = append(approvals, approval)
+	}
+	
+	return approvals
+}
+
+func (keeper Keeper) SetOperator(ctx sdk.Context, approval types.OperatorApproval) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.GetOperatorKey(approval.Owner, approval.Operator), keeper.cdc.MustMarshalBinaryLengthPrefixed(approval))
+}
+
+func (keeper Keeper) DeleteOperator(ctx sdk.Context, owner, operator string) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.GetOperatorKey(owner, operator))
+}
+
+func (keeper Keeper) IsOperator(ctx sdk.Context, owner, operator string) bool {
+	store := ctx.KVStore(keeper.storeKey)
+	return store.Has(types.GetOperatorKey(owner, operator))
+}
+
+func (keeper Keeper) GetOperators(ctx sdk.Context, owner string) []types.OperatorApproval {
+	return keeper.getOperators(ctx, types.GetOperatorsKey(owner))
+}
+
+func (keeper Keeper) GetAllOperators(ctx sdk.Context) []types.OperatorApproval {
+	return keeper.getOperators(ctx, types.OperatorPrefix)
+}
+
+func (keeper Keeper) getOperators(ctx sdk.Context, prefix []byte) []types.OperatorApproval {
+	store := ctx.KVStore(keeper.storeKey)
+	
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+	
+	operators := make([]types.OperatorApproval, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var approval types.OperatorApproval
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &approval)
+		operators = append(operators, approval)
+	}
+	
+	return operators
+}
+
+// IsOwnerOrApproved reports whether the address may manage the nft, either as its primary owner,
+// as the address approved for it or as an operator of the primary owner.
+func (keeper Keeper) IsOwnerOrApproved(ctx sdk.Context, nft types.BaseTweetNFT, addr sdk.AccAddress) bool {
+	if nft.PrimaryOwner == addr.String() {
+		return true
+	}
+	
+	if approval, found := keeper.GetNFTApproval(ctx, nft.PrimaryNFTID); found && approval.Approved == addr.String() {
+		return true
+	}
+	
+	return keeper.IsOperator(ctx, nft.PrimaryOwner, addr.String())
+}
+
+// ApproveNFT approves an address for a single nft, the primary owner and its operators can
+// approve.
+func (keeper Keeper) ApproveNFT(ctx sdk.Context, msg types.MsgApproveNFT) error {
+	nft, found := keeper.GetTweetNFTByID(ctx, msg.PrimaryNFTID)
+	if !found {
+		return sdkerrors.Wrap(types.ErrNFTNotFound, msg.PrimaryNFTID)
+	}
+	
+	if nft.PrimaryOwner != msg.Sender.String() && !keeper.IsOperator(ctx, nft.PrimaryOwner, msg.Sender.String()) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only primary owner or its operators can approve nft")
+	}
+	
+	keeper.SetNFTApproval(ctx, types.NewNFTApproval(nft.PrimaryNFTID, msg.Approved.String()))
+	return nil
+}
+
+func (keeper Keeper) RevokeNFTApproval(ctx sdk.Context, msg types.MsgRevokeNFTApproval) error {
+	nft, found := keeper.GetTweetNFTByID(ctx, msg.PrimaryNFTID)
+	if !found {
+		return sdkerrors.Wrap(types.ErrNFTNotFound, msg.PrimaryNFTID)
+	}
+	
+	if nft.PrimaryOwner != msg.Sender.String() && !keeper.IsOperator(ctx, nft.PrimaryOwner, msg.Sender.String()) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only primary owner or its operators can revoke approval")
+	} else if _, found := keeper.GetNFTApproval(ctx, nft.PrimaryNFTID); !found {
+		return sdkerrors.Wrap(types.ErrNFTApprovalNotFound, nft.PrimaryNFTID)
+	}
+	
+	keeper.DeleteNFTApproval(ctx, nft.PrimaryNFTID)
+	return nil
+}
@@ -0,0 +1,62 @@
+package keeper_test
+
+import (
+	"testing"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	
+	"github.com/FreeFlixMedia/modules/internal/testutil"
+	"github.com/FreeFlixMedia/modules/nfts/internal/types"
+)
+
+func TestApprovedAddressTransfersNFT(t *testing.T) {
+	ctx, k, _ := setupKeeper(t, types.FreeFlixContext)
+	
+	owner, approved, recipient := testutil.NewAddr(), testutil.NewAddr(), testutil.NewAddr()
+	nft := mintNFT(t, ctx, k, owner, owner, sdk.ZeroDec())
+	
+	if err := k.ApproveNFT(ctx, types.NewMsgApproveNFT(approved, nft.PrimaryNFTID, recipient)); err == nil {
+		t.Fatal("only the owner or its operators should approve")
+	}
+	if err := k.ApproveNFT(ctx, types.NewMsgApproveNFT(owner, nft.PrimaryNFTID, approved)); err != nil {
+		t.Fatal(err)
+	}
+	
+	if _, err := k.TransferOwnedTweetNFT(ctx, types.NewMsgTransferTweetNFT(approved, nft.PrimaryNFTID, recipient)); err != nil {
+		t.Fatal(err)
+	}
+	if transferred, _ := k.GetTweetNFTByID(ctx, nft.PrimaryNFTID); transferred.PrimaryOwner != recipient.String() {
+		t.Fatalf("nft should be owned by the recipient, owned by %s", transferred.PrimaryOwner)
+	}
+	
+	// the approval of the previous owner does not carry over to the recipient
+	if _, err := k.TransferOwnedTweetNFT(ctx, types.NewMsgTransferTweetNFT(approved, nft.PrimaryNFTID, approved)); err == nil {
+		t.Fatal("the approval should be cleared once the nft changes hands")
+	}
+}
+
+func TestOperatorManagesEveryNFTOfOwner(t *testing.T) {
+	ctx, k, _ := setupKeeper(t, types.FreeFlixContext)
+	
+	owner, operator, approved := testutil.NewAddr(), testutil.NewAddr(), testutil.NewAddr()
+	first := mintNFT(t, ctx, k, owner, owner, sdk.ZeroDec())
+	second := mintNFT(t, ctx, k, owner, owner, sdk.ZeroDec())
+	k.SetOperator(ctx, types.NewOperatorApproval(owner.String(), operator.String()))
+	
+	if _, err := k.ListTweetNFT(ctx, operator, first.PrimaryNFTID, testutil.Coin(100)); err != nil {
+		t.Fatal(err)
+	} else if listing, _ := k.GetListing(ctx, first.PrimaryNFTID); listing.Seller != owner.String() {
+		t.Fatalf("the owner should be the seller of a listing made by its operator, got %s", listing.Seller)
+	}
+	
+	if err := k.ApproveNFT(ctx, types.NewMsgApproveNFT(operator, second.PrimaryNFTID, approved)); err != nil {
+		t.Fatal(err)
+	} else if !k.IsOwnerOrApproved(ctx, second, approved) {
+		t.Fatal("the address approved by the operator should manage the nft")
+	}
+	
+	k.DeleteOperator(ctx, owner.String(), operator.String())
+	if k.IsOwnerOrApproved(ctx, second, operator) {
+		t.Fatal("a removed operator should no longer manage the nfts of the owner")
+	}
+}
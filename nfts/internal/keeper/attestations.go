@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	
+	"github.com/FreeFlixMedia/modules/nfts/internal/types"
+)
+
+func (keeper Keeper) SetHandleAttestation(ctx sdk.Context, attestation types.HandleAttestation) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.GetHandleAttestationKey(attestation.Platform, attestation.Handle),
+		keeper.cdc.MustMarshalBinaryLengthPrefixed(attestation))
+}
+
+func (keeper Keeper) GetHandleAttestation(ctx sdk.Context, platform, handle string) (types.HandleAttestation, bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	
+	bz := store.Get(types.GetHandleAttestationKey(platform, handle))
+	if bz == nil {
+		return types.HandleAttestation{}, false
+	}
+	
+	var attestation types.HandleAttestation
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &attestation)
+	return attestation, true
+}
+
+func (keeper Keeper) DeleteHandleAttestation(ctx sdk.Context, platform, handle string) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.GetHandleAttestationKey(platform, handle))
+}
+
+func (keeper Keeper) GetAllHandleAttestations(ctx sdk.Context) []types.HandleAttestation {
+	store := ctx.KVStore(keeper.storeKey)
+	
+	iterator := sdk.KVStorePrefixIterator(store, types.AttestationPrefix)
+	defer iterator.Close()
+	
+	attestations := make([]types.HandleAttestation, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var attestation types.HandleAttestation
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &attestation)
+		attestations = append(attestations, attestation)
+	}
+	
+	return attestations
+}
+
+// AttestHandle records the attestation of a verifier, a later attestation of the handle replaces
+// the earlier one.
+func (keeper Keeper) AttestHandle(ctx sdk.Context, msg types.MsgAttestHandle) error {
+	if !keeper.GetParams(ctx).IsVerifier(msg.Verifier.String()) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not a verifier", msg.Verifier)
+	}
+	
+	keeper.SetHandleAttestation(ctx, msg.Attestation(ctx.BlockTime()))
+	keeper.SyncProfileVerification(ctx, msg.Platform, msg.Handle)
+	return nil
+}
+
+func (keeper Keeper) RevokeHandleAttestation(ctx sdk.Context, msg types.MsgRevokeHandleAttestation) (types.HandleAttestation, error) {
+	if !keeper.GetParams(ctx).IsVerifier(msg.Verifier.String()) {
+		return types.HandleAttestation{}, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not a verifier", msg.Verifier)
+	}
+	
+	attestation, found := keeper.GetHandleAttestation(ctx, msg.Platform, msg.Handle)
+	if !found {
+		return types.HandleAttestation{}, sdkerrors.Wrapf(types.ErrAttestationNotFound, "%s handle %s", msg.Platform, msg.Handle)
+	}
+	
+	keeper.DeleteHandleAttestation(ctx, msg.Platform, msg.Handle)
+	keeper.SyncProfileVerification(ctx, msg.Platform, msg.Handle)
+	return attestation, nil
+}
+
+// ValidateHandleAttestation checks the attestation of the handle to the address, nothing is
+// checked unless params require attestations.
+func (keeper Keeper) ValidateHandleAttestation(ctx sdk.Context, platform, handle string, addr sdk.AccAddress) error {
+	if !keeper.GetParams(ctx).RequireHandleAttestation {
+		return nil
+	}
+	return keeper.CheckHandleAttestation(ctx, platform, handle, addr)
+}
+
+// CheckHandleAttestation checks the handle is attested to the address by a current verifier and
+// the attestation has not expired.
+func (keeper Keeper) CheckHandleAttestation(ctx sdk.Context, platform, handle string, addr sdk.AccAddress) error {
+	params := keeper.GetParams(ctx)
+	attestation, found := keeper.GetHandleAttestation(ctx, platform, handle)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrAttestationNotFound, "%s handle %s", platform, handle)
+	} else if attestation.Address != addr.String() {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s handle %s is not attested to %s", platform, handle, addr)
+	} else if attestation.IsExpired(ctx.BlockTime()) {
+		return sdkerrors.Wrapf(types.ErrInvalidAttestation, "attestation of %s handle %s expired", platform, handle)
+	} else if !params.IsVerifier(attestation.Verifier) {
+		return sdkerrors.Wrapf(types.ErrInvalidAttestation, "%s is no longer a verifier", attestation.Verifier)
+	}
+	return nil
+}
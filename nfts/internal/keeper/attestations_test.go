@@ -0,0 +1,52 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	
+	"github.com/FreeFlixMedia/modules/internal/testutil"
+	"github.com/FreeFlixMedia/modules/nfts/internal/types"
+)
+
+func TestMintRequiresHandleAttestation(t *testing.T) {
+	ctx, k, _ := setupKeeper(t, types.FreeFlixContext)
+	
+	verifier, creator := testutil.NewAddr(), testutil.NewAddr()
+	params := k.GetParams(ctx)
+	params.Verifiers = []string{verifier.String()}
+	params.RequireHandleAttestation = true
+	k.SetParams(ctx, params)
+	
+	validate := func(ctx sdk.Context) error {
+		msg := types.NewMsgMintNFT(creator, "", testutil.NewAddr().String(), nil, testutil.Coin(10), sdk.NewDecWithPrec(5, 1),
+			0, 0, sdk.ZeroDec(), nil, types.PlatformTwitter, "creator", types.Metadata{})
+		_, err := k.ValidateMint(ctx, msg)
+		return err
+	}
+	
+	if err := validate(ctx); err == nil {
+		t.Fatal("a handle without an attestation should not be minted")
+	}
+	if err := k.AttestHandle(ctx, types.NewMsgAttestHandle(creator, types.PlatformTwitter, "creator", creator, 60)); err == nil {
+		t.Fatal("only verifiers should attest handles")
+	}
+	
+	if err := k.AttestHandle(ctx, types.NewMsgAttestHandle(verifier, types.PlatformTwitter, "creator", creator, 60)); err != nil {
+		t.Fatal(err)
+	}
+	if err := validate(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if err := validate(ctx.WithBlockTime(ctx.BlockTime().Add(time.Minute))); err == nil {
+		t.Fatal("an expired attestation should not allow minting")
+	}
+	
+	if _, err := k.RevokeHandleAttestation(ctx, types.NewMsgRevokeHandleAttestation(verifier, types.PlatformTwitter, "creator")); err != nil {
+		t.Fatal(err)
+	}
+	if err := validate(ctx); err == nil {
+		t.Fatal("a revoked attestation should not allow minting")
+	}
+}
@@ -0,0 +1,307 @@
+package keeper
+
+import (
+	"time"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	
+	"github.com/FreeFlixMedia/modules/nfts/internal/types"
+)
+
+func (keeper Keeper) GetAuctionCount(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(keeper.storeKey)
+	
+	bz := store.Get(types.AuctionCountKey)
+	if bz == nil {
+		return 0
+	}
+	
+	var count uint64
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &count)
+	return count
+}
+
+func (keeper Keeper) SetAuctionCount(ctx sdk.Context, count uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.AuctionCountKey, keeper.cdc.MustMarshalBinaryLengthPrefixed(count))
+}
+
+func (keeper Keeper) SetAuction(ctx sdk.Context, auction types.Auction) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.GetAuctionKey(auction.ID), keeper.cdc.MustMarshalBinaryLengthPrefixed(auction))
+}
+
+func (keeper Keeper) GetAuction(ctx sdk.Context, id uint64) (types.Auction, bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	
+	bz := store.Get(types.GetAuctionKey(id))
+	if bz == nil {
+		return types.Auction{}, false
+	}
+	
+	var auction types.Auction
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &auction)
+	return auction, true
+}
+
+func (keeper Keeper) DeleteAuction(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.GetAuctionKey(id))
+}
+
+func (keeper Keeper) GetAllAuctions(ctx sdk.Context) []types.Auction {
+	store := ctx.KVStore(keeper.storeKey)
+	
+	iterator := sdk.KVStorePrefixIterator(store, types.AuctionPrefix)
+	defer iterator.Close()
+	
+	auctions := make([]types.Auction, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var auction types.Auction
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &auction)
+		auctions = append(auctions, auction)
+	}
+	
+	return auctions
+}
+
+func (keeper Keeper) InsertAuctionQueue(ctx sdk.Context, auction types.Auction) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.GetAuctionQueueKey(auction.Kind, auction.EndTime, auction.ID), types.GetAuctionKey(auction.ID))
+}
+
+func (keeper Keeper) RemoveFromAuctionQueue(ctx sdk.Context, auction types.Auction) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.GetAuctionQueueKey(auction.Kind, auction.EndTime, auction.ID))
+}
+
+// DequeueEndedAuctions removes and returns every active auction of the kind that ended at or before the current block time.
+func (keeper Keeper) DequeueEndedAuctions(ctx sdk.Context, kind string) []types.Auction {
+	store := ctx.KVStore(keeper.storeKey)
+	
+	iterator := store.Iterator(append(types.AuctionQueuePrefix, []byte(kind+"/")...),
+		sdk.PrefixEndBytes(types.GetAuctionQueueTimeKey(kind, ctx.BlockTime())))
+	
+	var keys [][]byte
+	var auctions []types.Auction
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+		
+		bz := store.Get(iterator.Value())
+		if bz == nil {
+			continue
+		}
+		
+		var auction types.Auction
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &auction)
+		if auction.IsActive() {
+			auctions = append(auctions, auction)
+		}
+	}
+	iterator.Close()
+	
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	
+	return auctions
+}
+
+// HasActiveLicenseAuction tells whether the license of the nft is being auctioned.
+func (keeper Keeper) HasActiveLicenseAuction(ctx sdk.Context, primaryNFTID string) bool {
+	for _, auction := range keeper.GetAllAuctions(ctx) {
+		if auction.PrimaryNFTID == primaryNFTID && auction.Kind == types.AuctionKindLicense && auction.IsActive() {
+			return true
+		}
+	}
+	return false
+}
+
+// CreateAuction opens an auction on a primary nft, an ownership auction holds the nft in the
+// module account until the auction is settled.
+func (keeper Keeper) CreateAuction(ctx sdk.Context, msg types.MsgCreateAuction) (types.Auction, error) {
+	if types.GetContextOfCurrentChain() != types.FreeFlixContext {
+		return types.Auction{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "only primary nfts can be auctioned")
+	}
+	
+	nft, found := keeper.GetTweetNFTByID(ctx, msg.PrimaryNFTID)
+	if !found {
+		return types.Auction{}, sdkerrors.Wrap(types.ErrNFTNotFound, msg.PrimaryNFTID)
+	}
+	
+	if !keeper.IsOwnerOrApproved(ctx, nft, msg.Sender) {
+		return types.Auction{}, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only primary owner or approved can auction nft")
+	} else if nft.IsCoOwned() {
+		return types.Auction{}, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is co-owned", nft.PrimaryNFTID)
+	}
+	
+	if keeper.IsEscrowed(ctx, nft.PrimaryNFTID) {
+		return types.Auction{}, sdkerrors.Wrap(types.ErrNFTEscrowed, nft.PrimaryNFTID)
+	} else if msg.Kind == types.AuctionKindOwnership && keeper.HasActiveLicenseAuction(ctx, nft.PrimaryNFTID) {
+		return types.Auction{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "the license of %s is being auctioned", nft.PrimaryNFTID)
+	}
+	
+	if msg.Kind == types.AuctionKindLicense {
+		if !nft.IsLicensable() {
+			return types.Auction{}, sdkerrors.Wrapf(types.ErrInvalidLicense, "%s is not licensable", nft.PrimaryNFTID)
+		} else if !nft.LicenseTerms.IsChannelPermitted(msg.Channel) {
+			return types.Auction{}, sdkerrors.Wrapf(types.ErrInvalidLicense, "%s can not be licensed over %s", nft.PrimaryNFTID, msg.Channel)
+		}
+	}
+	
+	count := keeper.GetAuctionCount(ctx)
+	startTime := ctx.BlockTime()
+	endTime := startTime.Add(time.Duration(msg.DurationSeconds) * time.Second)
+	auction := types.NewAuction(count, msg.PrimaryNFTID, nft.PrimaryOwner, msg.Kind, msg.AuctionType,
+		msg.StartPrice, msg.FloorPrice, startTime, endTime, msg.Channel)
+	
+	if msg.Kind == types.AuctionKindOwnership {
+		seller, err := sdk.AccAddressFromBech32(nft.PrimaryOwner)
+		if err != nil {
+			return types.Auction{}, err
+		}
+		keeper.EscrowTweetNFT(ctx, nft, seller)
+	}
+	
+	keeper.SetAuction(ctx, auction)
+	keeper.InsertAuctionQueue(ctx, auction)
+	keeper.SetAuctionCount(ctx, count+1)
+	return auction, nil
+}
+
+// PlaceBid escrows the bid in the module account and refunds the outbid bidder. The first valid
+// bid on a dutch auction wins it, the auction then ends in the current block.
+func (keeper Keeper) PlaceBid(ctx sdk.Context, msg types.MsgPlaceBid) (types.Auction, error) {
+	auction, found := keeper.GetAuction(ctx, msg.AuctionID)
+	if !found {
+		return types.Auction{}, sdkerrors.Wrapf(types.ErrAuctionNotFound, "%d", msg.AuctionID)
+	}
+	
+	if auction.Seller == msg.Bidder.String() {
+		return types.Auction{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "seller can not bid on own auction")
+	} else if auction.Kind == types.AuctionKindLicense && msg.Licensee == "" {
+		return types.Auction{}, sdkerrors.Wrap(types.ErrInvalidBid, "licensee should not be empty")
+	}
+	
+	amount, err := auction.ValidateBid(msg.Amount, ctx.BlockTime())
+	if err != nil {
+		return types.Auction{}, err
+	}
+	
+	if err := keeper.bankKeeper.SendCoins(ctx, msg.Bidder, types.ModuleAddress, sdk.Coins{amount}); err != nil {
+		return types.Auction{}, err
+	}
+	
+	if auction.HasBid() {
+		if err := keeper.refundBid(ctx, auction); err != nil {
+			return types.Auction{}, err
+		}
+	}
+	
+	auction.HighestBid = amount
+	auction.HighestBidder = msg.Bidder.String()
+	auction.Licensee = msg.Licensee
+	
+	if auction.AuctionType == types.AuctionTypeDutch {
+		keeper.RemoveFromAuctionQueue(ctx, auction)
+		auction.EndTime = ctx.BlockTime()
+		keeper.InsertAuctionQueue(ctx, auction)
+	}
+	
+	keeper.SetAuction(ctx, auction)
+	return auction, nil
+}
+
+// CancelAuction closes an auction that has not received any bid yet.
+func (keeper Keeper) CancelAuction(ctx sdk.Context, sender sdk.AccAddress, id uint64) (types.Auction, error) {
+	auction, found := keeper.GetAuction(ctx, id)
+	if !found {
+		return types.Auction{}, sdkerrors.Wrapf(types.ErrAuctionNotFound, "%d", id)
+	}
+	
+	if auction.Seller != sender.String() {
+		nft, found := keeper.GetTweetNFTByID(ctx, auction.PrimaryNFTID)
+		if found {
+			nft.PrimaryOwner = auction.Seller
+		}
+		if !found || !keeper.IsOwnerOrApproved(ctx, nft, sender) {
+			return types.Auction{}, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only seller or approved can cancel auction")
+		}
+	}
+	
+	if !auction.IsActive() || auction.HasBid() {
+		return types.Auction{}, sdkerrors.Wrapf(types.ErrAuctionClosed, "auction %d already has bids", id)
+	}
+	
+	keeper.RemoveFromAuctionQueue(ctx, auction)
+	return auction, keeper.CloseAuction(ctx, auction)
+}
+
+func (keeper Keeper) refundBid(ctx sdk.Context, auction types.Auction) error {
+	bidder, err := sdk.AccAddressFromBech32(auction.HighestBidder)
+	if err != nil {
+		return err
+	}
+	
+	return keeper.bankKeeper.SendCoins(ctx, types.ModuleAddress, bidder, sdk.Coins{auction.HighestBid})
+}
+
+// CloseAuction ends an auction without a sale, the highest bid is refunded and an escrowed nft
+// is released to the seller.
+func (keeper Keeper) CloseAuction(ctx sdk.Context, auction types.Auction) error {
+	if auction.HasBid() {
+		if err := keeper.refundBid(ctx, auction); err != nil {
+			return err
+		}
+	}
+	
+	if auction.Kind == types.AuctionKindOwnership {
+		if err := keeper.ReleaseTweetNFT(ctx, auction.PrimaryNFTID); err != nil {
+			return err
+		}
+	}
+	
+	keeper.DeleteAuction(ctx, auction.ID)
+	return nil
+}
+
+// SettleAuction pays the seller out of the escrowed winning bid and hands an auctioned nft to
+// the winner, the creator royalty applies to ownership auctions and the proceeds of license
+// auctions follow the revenue splits. Auctions without bids are closed.
+func (keeper Keeper) SettleAuction(ctx sdk.Context, auction types.Auction) (sdk.Coin, error) {
+	noRoyalty := sdk.NewCoin(auction.StartPrice.Denom, sdk.ZeroInt())
+	if !auction.HasBid() {
+		return noRoyalty, keeper.CloseAuction(ctx, auction)
+	}
+	
+	nft, found := keeper.GetTweetNFTByID(ctx, auction.PrimaryNFTID)
+	if !found {
+		return sdk.Coin{}, sdkerrors.Wrap(types.ErrNFTNotFound, auction.PrimaryNFTID)
+	}
+	
+	if auction.Kind == types.AuctionKindLicense {
+		keeper.DeleteAuction(ctx, auction.ID)
+		return noRoyalty, keeper.DistributeRevenue(ctx, nft, types.ModuleAddress, auction.HighestBid)
+	}
+	
+	seller, err := sdk.AccAddressFromBech32(auction.Seller)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	
+	winner, err := sdk.AccAddressFromBech32(auction.HighestBidder)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	
+	royalty, err := keeper.PayForTweetNFT(ctx, nft, types.ModuleAddress, seller, auction.HighestBid)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	
+	keeper.TransferTweetNFT(ctx, nft, types.ModuleAddress, winner)
+	keeper.DeleteEscrow(ctx, auction.PrimaryNFTID)
+	keeper.DeleteAuction(ctx, auction.ID)
+	return royalty, nil
+}
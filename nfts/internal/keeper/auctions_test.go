@@ -0,0 +1,94 @@
+package keeper_test
+
+import (
+	"testing"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	
+	"github.com/FreeFlixMedia/modules/internal/testutil"
+	"github.com/FreeFlixMedia/modules/nfts/internal/types"
+)
+
+func TestSettleOwnershipAuction(t *testing.T) {
+	ctx, k, bank := setupKeeper(t, types.FreeFlixContext)
+	
+	creator, seller, first, second := testutil.NewAddr(), testutil.NewAddr(), testutil.NewAddr(), testutil.NewAddr()
+	nft := mintNFT(t, ctx, k, creator, seller, sdk.NewDecWithPrec(1, 1))
+	bank.SetBalance(first, 500)
+	bank.SetBalance(second, 500)
+	
+	auction, err := k.CreateAuction(ctx, types.NewMsgCreateAuction(seller, nft.PrimaryNFTID, types.AuctionKindOwnership,
+		types.AuctionTypeEnglish, testutil.Coin(100), testutil.Coin(100), 3600, ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	
+	if _, err := k.PlaceBid(ctx, types.NewMsgPlaceBid(first, auction.ID, testutil.Coin(200), "")); err != nil {
+		t.Fatal(err)
+	}
+	auction, err = k.PlaceBid(ctx, types.NewMsgPlaceBid(second, auction.ID, testutil.Coin(300), ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	
+	// the outbid bidder is refunded and only the highest bid stays escrowed
+	testutil.RequireBalance(t, bank, first, 500)
+	testutil.RequireBalance(t, bank, second, 200)
+	testutil.RequireBalance(t, bank, types.ModuleAddress, 300)
+	
+	royalty, err := k.SettleAuction(ctx, auction)
+	if err != nil {
+		t.Fatal(err)
+	} else if !royalty.IsEqual(testutil.Coin(30)) {
+		t.Fatalf("expected royalty of 30%s, got %s", testutil.Denom, royalty)
+	}
+	
+	testutil.RequireBalance(t, bank, types.ModuleAddress, 0)
+	testutil.RequireBalance(t, bank, creator, 30)
+	testutil.RequireBalance(t, bank, seller, 270)
+	
+	won, _ := k.GetTweetNFTByID(ctx, nft.PrimaryNFTID)
+	if won.PrimaryOwner != second.String() {
+		t.Fatalf("auctioned nft should be owned by the winner, owned by %s", won.PrimaryOwner)
+	} else if k.IsEscrowed(ctx, nft.PrimaryNFTID) {
+		t.Fatal("settled nft should no longer be escrowed")
+	}
+}
+
+func TestCloseAuctionRefundsBid(t *testing.T) {
+	ctx, k, bank := setupKeeper(t, types.FreeFlixContext)
+	
+	seller, bidder := testutil.NewAddr(), testutil.NewAddr()
+	nft := mintNFT(t, ctx, k, seller, seller, sdk.ZeroDec())
+	bank.SetBalance(bidder, 500)
+	
+	auction, err := k.CreateAuction(ctx, types.NewMsgCreateAuction(seller, nft.PrimaryNFTID, types.AuctionKindOwnership,
+		types.AuctionTypeEnglish, testutil.Coin(100), testutil.Coin(100), 3600, ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	
+	auctioned, _ := k.GetTweetNFTByID(ctx, nft.PrimaryNFTID)
+	if auctioned.PrimaryOwner != types.ModuleAddress.String() {
+		t.Fatalf("auctioned nft should be held by the module account, owned by %s", auctioned.PrimaryOwner)
+	}
+	
+	auction, err = k.PlaceBid(ctx, types.NewMsgPlaceBid(bidder, auction.ID, testutil.Coin(150), ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	
+	if err := k.CloseAuction(ctx, auction); err != nil {
+		t.Fatal(err)
+	}
+	
+	testutil.RequireBalance(t, bank, bidder, 500)
+	testutil.RequireBalance(t, bank, types.ModuleAddress, 0)
+	if k.IsEscrowed(ctx, nft.PrimaryNFTID) {
+		t.Fatal("closed auction should release the escrow")
+	} else if released, _ := k.GetTweetNFTByID(ctx, nft.PrimaryNFTID); released.PrimaryOwner != seller.String() {
+		t.Fatalf("closed auction should hand the nft back to the seller, owned by %s", released.PrimaryOwner)
+	} else if _, found := k.GetAuction(ctx, auction.ID); found {
+		t.Fatal("closed auction should be removed")
+	}
+}
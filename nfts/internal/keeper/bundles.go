@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	
+	"github.com/FreeFlixMedia/modules/nfts/internal/types"
+)
+
+func (keeper Keeper) GetBundleCount(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(keeper.storeKey)
+	
+	bz := store.Get(types.BundleCountKey)
+	if bz == nil {
+		return 0
+	}
+	
+	var count uint64
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &count)
+	return count
+}
+
+func (keeper Keeper) SetBundleCount(ctx sdk.Context, count uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.BundleCountKey, keeper.cdc.MustMarshalBinaryLengthPrefixed(count))
+}
+
+func (keeper Keeper) SetBundle(ctx sdk.Context, bundle types.Bundle) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.GetBundleKey(bundle.ID), keeper.cdc.MustMarshalBinaryLengthPrefixed(bundle))
+}
+
+func (keeper Keeper) GetBundle(ctx sdk.Context, id uint64) (types.Bundle, bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	
+	bz := store.Get(types.GetBundleKey(id))
+	if bz == nil {
+		return types.Bundle{}, false
+	}
+	
+	var bundle types.Bundle
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &bundle)
+	return bundle, true
+}
+
+func (keeper Keeper) DeleteBundle(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.GetBundleKey(id))
+}
+
+func (keeper Keeper) GetAllBundles(ctx sdk.Context) []types.Bundle {
+	store := ctx.KVStore(keeper.storeKey)
+	
+	iterator := sdk.KVStorePrefixIterator(store, types.BundlePrefix)
+	defer iterator.Close()
+	
+	bundles := make([]types.Bundle, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var bundle types.Bundle
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &bundle)
+		bundles = append(bundles, bundle)
+	}
+	
+	return bundles
+}
+
+// CreateBundle groups nfts the sender owns or is approved for under a single price and shared
+// license terms.
+func (keeper Keeper) CreateBundle(ctx sdk.Context, msg types.MsgCreateBundle) (types.Bundle, error) {
+	if types.GetContextOfCurrentChain() != types.FreeFlixContext {
+		return types.Bundle{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "only primary nfts can be bundled")
+	}
+	
+	for _, member := range msg.Members {
+		nft, found := keeper.GetTweetNFTByID(ctx, member.PrimaryNFTID)
+		if !found {
+			return types.Bundle{}, sdkerrors.Wrap(types.ErrNFTNotFound, member.PrimaryNFTID)
+		}
+		
+		if !keeper.IsOwnerOrApproved(ctx, nft, msg.Sender) {
+			return types.Bundle{}, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "sender is neither the primary owner of %s nor approved", member.PrimaryNFTID)
+		} else if !nft.IsLicensable() {
+			return types.Bundle{}, sdkerrors.Wrapf(types.ErrInvalidLicense, "%s is not licensable", member.PrimaryNFTID)
+		}
+	}
+	
+	count := keeper.GetBundleCount(ctx)
+	bundle := types.NewBundle(count, msg)
+	
+	keeper.SetBundle(ctx, bundle)
+	keeper.SetBundleCount(ctx, count+1)
+	return bundle, nil
+}
+
+func (keeper Keeper) RemoveBundle(ctx sdk.Context, sender sdk.AccAddress, id uint64) (types.Bundle, error) {
+	bundle, found := keeper.GetBundle(ctx, id)
+	if !found {
+		return types.Bundle{}, sdkerrors.Wrapf(types.ErrBundleNotFound, "%d", id)
+	}
+	
+	if bundle.Owner != sender.String() {
+		return types.Bundle{}, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only bundle owner can delete bundle")
+	}
+	
+	keeper.DeleteBundle(ctx, id)
+	return bundle, nil
+}
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	
+	"github.com/FreeFlixMedia/modules/nfts/internal/types"
+)
+
+func (keeper Keeper) SetCollection(ctx sdk.Context, collection types.Collection) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.GetCollectionKey(collection.ID), keeper.cdc.MustMarshalBinaryLengthPrefixed(collection))
+}
+
+func (keeper Keeper) GetCollection(ctx sdk.Context, id string) (types.Collection, bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	
+	bz := store.Get(types.GetCollectionKey(id))
+	if bz == nil {
+		return types.Collection{}, false
+	}
+	
+	var collection types.Collection
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &collection)
+	return collection, true
+}
+
+func (keeper Keeper) GetAllCollections(ctx sdk.Context) []types.Collection {
+	store := ctx.KVStore(keeper.storeKey)
+	
+	iterator := sdk.KVStorePrefixIterator(store, types.CollectionPrefix)
+	defer iterator.Close()
+	
+	collections := make([]types.Collection, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var collection types.Collection
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &collection)
+		collections = append(collections, collection)
+	}
+	
+	return collections
+}
+
+func (keeper Keeper) CreateCollection(ctx sdk.Context, msg types.MsgCreateCollection) (types.Collection, error) {
+	if _, found := keeper.GetCollection(ctx, msg.ID); found {
+		return types.Collection{}, sdkerrors.Wrapf(types.ErrInvalidCollection, "collection %s already exists", msg.ID)
+	}
+	
+	collection := types.NewCollection(msg.ID, msg.Name, msg.Description, msg.Schema, msg.Sender.String(),
+		msg.MintPermission, msg.Minters)
+	keeper.SetCollection(ctx, collection)
+	return collection, nil
+}
+
+func (keeper Keeper) GetNFTsOfCollection(ctx sdk.Context, id string) []types.BaseTweetNFT {
+	nfts := make([]types.BaseTweetNFT, 0)
+	for _, nft := range keeper.GetAllTweetNFTs(ctx) {
+		if nft.CollectionID == id {
+			nfts = append(nfts, nft)
+		}
+	}
+	return nfts
+}
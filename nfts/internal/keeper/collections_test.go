@@ -0,0 +1,47 @@
+package keeper_test
+
+import (
+	"testing"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	
+	"github.com/FreeFlixMedia/modules/internal/testutil"
+	"github.com/FreeFlixMedia/modules/nfts/internal/types"
+)
+
+func TestMintIntoCollection(t *testing.T) {
+	ctx, k, _ := setupKeeper(t, types.FreeFlixContext)
+	
+	creator, minter, stranger := testutil.NewAddr(), testutil.NewAddr(), testutil.NewAddr()
+	msg := types.NewMsgCreateCollection(creator, "clips", "Clips", "", "", types.MintPermissionAllowlist, []string{minter.String()})
+	if _, err := k.CreateCollection(ctx, msg); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := k.CreateCollection(ctx, msg); err == nil {
+		t.Fatal("a collection id should only be taken once")
+	}
+	
+	mint := func(sender sdk.AccAddress) (types.BaseTweetNFT, error) {
+		msg := types.NewMsgMintNFT(sender, "clips", testutil.NewAddr().String(), nil, testutil.Coin(10), sdk.NewDecWithPrec(5, 1),
+			0, 0, sdk.ZeroDec(), nil, types.PlatformGeneric, "creator", types.Metadata{})
+		royaltyRate, err := k.ValidateMint(ctx, msg)
+		if err != nil {
+			return types.BaseTweetNFT{}, err
+		}
+		return k.MintNewTweetNFT(ctx, msg, royaltyRate), nil
+	}
+	
+	if _, err := mint(stranger); err == nil {
+		t.Fatal("only the creator and the allowed minters should mint into the collection")
+	}
+	count := k.GetGlobalTweetCount(ctx)
+	nft, err := mint(minter)
+	if err != nil {
+		t.Fatal(err)
+	} else if nft.PrimaryNFTID != types.GetCollectionNFTID("clips", count) {
+		t.Fatalf("the nft should be minted under the id prefix of the collection, got %s", nft.PrimaryNFTID)
+	}
+	if nfts := k.GetNFTsOfCollection(ctx, "clips"); len(nfts) != 1 || nfts[0].PrimaryNFTID != nft.PrimaryNFTID {
+		t.Fatalf("the collection should hold the minted nft, got %v", nfts)
+	}
+}
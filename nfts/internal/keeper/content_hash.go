@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	
+	"github.com/FreeFlixMedia/modules/nfts/internal/types"
+)
+
+// SetContentHashNFT indexes the nft minted for the content hash, the hash of a master is shared
+// by its editions and only resolves to the master.
+func (keeper Keeper) SetContentHashNFT(ctx sdk.Context, hash, primaryNFTID string) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.GetContentHashKey(hash), []byte(primaryNFTID))
+}
+
+func (keeper Keeper) GetNFTIDByContentHash(ctx sdk.Context, hash string) (string, bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	
+	bz := store.Get(types.GetContentHashKey(hash))
+	if bz == nil {
+		return "", false
+	}
+	return string(bz), true
+}
+
+func (keeper Keeper) DeleteContentHashNFT(ctx sdk.Context, hash string) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.GetContentHashKey(hash))
+}
+
+func (keeper Keeper) GetNFTByContentHash(ctx sdk.Context, hash string) (types.BaseTweetNFT, bool) {
+	id, found := keeper.GetNFTIDByContentHash(ctx, hash)
+	if !found {
+		return types.BaseTweetNFT{}, false
+	}
+	return keeper.GetTweetNFTByID(ctx, id)
+}
@@ -0,0 +1,49 @@
+package keeper_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	
+	"github.com/FreeFlixMedia/modules/internal/testutil"
+	"github.com/FreeFlixMedia/modules/nfts/internal/types"
+)
+
+func TestContentHashIsUniqueChainWide(t *testing.T) {
+	ctx, k, _ := setupKeeper(t, types.FreeFlixContext)
+	
+	digest := sha256.Sum256([]byte("clip"))
+	hash := hex.EncodeToString(digest[:])
+	mint := func(sender sdk.AccAddress) (types.BaseTweetNFT, error) {
+		metadata := types.Metadata{ContentHash: hash, HashAlgorithm: types.HashAlgorithmSHA256}
+		msg := types.NewMsgMintNFT(sender, "", testutil.NewAddr().String(), nil, testutil.Coin(10), sdk.NewDecWithPrec(5, 1),
+			0, 0, sdk.ZeroDec(), nil, types.PlatformGeneric, "creator", metadata)
+		royaltyRate, err := k.ValidateMint(ctx, msg)
+		if err != nil {
+			return types.BaseTweetNFT{}, err
+		}
+		return k.MintNewTweetNFT(ctx, msg, royaltyRate), nil
+	}
+	
+	owner := testutil.NewAddr()
+	nft, err := mint(owner)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resolved, found := k.GetNFTByContentHash(ctx, hash); !found || resolved.PrimaryNFTID != nft.PrimaryNFTID {
+		t.Fatal("the content hash should resolve to the minted nft")
+	}
+	
+	if _, err := mint(testutil.NewAddr()); err == nil {
+		t.Fatal("a content hash should only be minted once across accounts")
+	}
+	
+	if err := k.BurnTweetNFT(ctx, nft, owner); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := mint(testutil.NewAddr()); err != nil {
+		t.Fatalf("the content hash of a burned nft should be free again: %s", err)
+	}
+}
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	
+	"github.com/FreeFlixMedia/modules/nfts/internal/types"
+)
+
+// MintEdition mints the next numbered edition of the master to the recipient, only the owner of
+// the master or an approved address can mint editions until the supply of the master is reached.
+func (keeper Keeper) MintEdition(ctx sdk.Context, msg types.MsgMintEdition) (types.BaseTweetNFT, error) {
+	master, found := keeper.GetTweetNFTByID(ctx, msg.MasterNFTID)
+	if !found {
+		return types.BaseTweetNFT{}, sdkerrors.Wrap(types.ErrNFTNotFound, msg.MasterNFTID)
+	}
+	
+	if !master.IsMaster() {
+		return types.BaseTweetNFT{}, sdkerrors.Wrapf(types.ErrInvalidEdition, "%s is not a master", master.PrimaryNFTID)
+	} else if !master.HasEditionsLeft() {
+		return types.BaseTweetNFT{}, sdkerrors.Wrapf(types.ErrEditionSupplyReached, "all %d editions of %s are minted",
+			master.MaxEditions, master.PrimaryNFTID)
+	} else if !keeper.IsOwnerOrApproved(ctx, master, msg.Sender) {
+		return types.BaseTweetNFT{}, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "sender is neither the master owner nor approved")
+	}
+	
+	master.EditionCount++
+	edition := master.Edition(master.EditionCount, msg.Recipient.String())
+	keeper.MintTweetNFT(ctx, master)
+	keeper.MintTweetNFT(ctx, edition)
+	keeper.SetTweetIDToAccount(ctx, msg.Recipient, edition.PrimaryNFTID)
+	keeper.LinkProfile(ctx, edition)
+	return edition, nil
+}
+
+func (keeper Keeper) GetEditionsOfMaster(ctx sdk.Context, masterNFTID string) []types.BaseTweetNFT {
+	editions := make([]types.BaseTweetNFT, 0)
+	for _, nft := range keeper.GetAllTweetNFTs(ctx) {
+		if nft.MasterNFTID == masterNFTID {
+			editions = append(editions, nft)
+		}
+	}
+	return editions
+}
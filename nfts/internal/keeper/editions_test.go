@@ -0,0 +1,44 @@
+package keeper_test
+
+import (
+	"testing"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	
+	"github.com/FreeFlixMedia/modules/internal/testutil"
+	"github.com/FreeFlixMedia/modules/nfts/internal/types"
+)
+
+func TestMintEdition(t *testing.T) {
+	ctx, k, _ := setupKeeper(t, types.FreeFlixContext)
+	
+	owner, collector := testutil.NewAddr(), testutil.NewAddr()
+	msg := types.NewMsgMintNFT(owner, "", testutil.NewAddr().String(), nil, testutil.Coin(10), sdk.NewDecWithPrec(5, 1),
+		0, 2, sdk.ZeroDec(), nil, types.PlatformGeneric, "creator", types.Metadata{})
+	royaltyRate, err := k.ValidateMint(ctx, msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	master := k.MintNewTweetNFT(ctx, msg, royaltyRate)
+	count := k.GetGlobalTweetCount(ctx)
+	
+	if _, err := k.MintEdition(ctx, types.NewMsgMintEdition(collector, master.PrimaryNFTID, collector)); err == nil {
+		t.Fatal("only the owner of the master should mint editions")
+	}
+	
+	for number := uint64(1); number <= 2; number++ {
+		edition, err := k.MintEdition(ctx, types.NewMsgMintEdition(owner, master.PrimaryNFTID, collector))
+		if err != nil {
+			t.Fatal(err)
+		} else if edition.PrimaryNFTID != types.GetEditionNFTID(master.PrimaryNFTID, number) {
+			t.Fatalf("edition %d should be numbered after its master, got %s", number, edition.PrimaryNFTID)
+		}
+	}
+	
+	if _, err := k.MintEdition(ctx, types.NewMsgMintEdition(owner, master.PrimaryNFTID, collector)); err == nil {
+		t.Fatal("editions should not be minted past the supply of the master")
+	}
+	if k.GetGlobalTweetCount(ctx) != count {
+		t.Fatal("editions are numbered after their master and should not take a global count")
+	}
+}
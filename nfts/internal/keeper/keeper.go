@@ -5,23 +5,44 @@ import (
 	
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/tendermint/tendermint/libs/log"
 	
 	"github.com/FreeFlixMedia/modules/nfts/internal/types"
 )
 
 type Keeper struct {
-	storeKey sdk.StoreKey
-	cdc      *codec.Codec
+	storeKey   sdk.StoreKey
+	cdc        *codec.Codec
+	bankKeeper types.BankKeeper
+	paramSpace paramtypes.Subspace
+	hooks      types.NFTHooks
 }
 
-func NewKeeper(cdc *codec.Codec, key sdk.StoreKey) Keeper {
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, bankKeeper types.BankKeeper, paramSpace paramtypes.Subspace) Keeper {
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+	
 	return Keeper{
-		storeKey: key,
-		cdc:      cdc,
+		storeKey:   key,
+		cdc:        cdc,
+		bankKeeper: bankKeeper,
+		paramSpace: paramSpace,
 	}
 }
 
+// SetHooks sets the hooks called before an nft is burned, they can only be set once.
+func (keeper Keeper) SetHooks(hooks types.NFTHooks) Keeper {
+	if keeper.hooks != nil {
+		panic("cannot set nfts hooks twice")
+	}
+	
+	keeper.hooks = hooks
+	return keeper
+}
+
 func (keeper Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
@@ -69,6 +90,8 @@ func (keeper Keeper) GetTweetNFTByID(ctx sdk.Context, id string) (types.BaseTwee
 	var nft types.BaseTweetNFT
 	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &nft)
 	
+	// nfts stored before platforms were introduced are read as tweets until they are migrated
+	nft.Platform = types.NormalizePlatform(nft.Platform)
 	return nft, true
 }
 
@@ -80,6 +103,19 @@ func (keeper Keeper) SetTweetIDToAccount(ctx sdk.Context, addr sdk.AccAddress, i
 	store.Set(types.GetTweetsCountOfAddressKey(addr), keeper.cdc.MustMarshalBinaryLengthPrefixed(tweetIDs))
 }
 
+func (keeper Keeper) RemoveTweetIDFromAccount(ctx sdk.Context, addr sdk.AccAddress, id string) {
+	tweetIDs := keeper.GetTweetIDsOfAccount(ctx, addr)
+	for i, tweetID := range tweetIDs {
+		if tweetID == id {
+			tweetIDs = append(tweetIDs[:i], tweetIDs[i+1:]...)
+			break
+		}
+	}
+	
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.GetTweetsCountOfAddressKey(addr), keeper.cdc.MustMarshalBinaryLengthPrefixed(tweetIDs))
+}
+
 func (keeper Keeper) GetTweetIDsOfAccount(ctx sdk.Context, addr sdk.AccAddress) []string {
 	store := ctx.KVStore(keeper.storeKey)
 	
@@ -118,8 +154,96 @@ func (keeper Keeper) GetAllTweetNFTs(ctx sdk.Context) []types.BaseTweetNFT {
 		var nft types.BaseTweetNFT
 		value := iterator.Value()
 		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(value, &nft)
+		nft.Platform = types.NormalizePlatform(nft.Platform)
 		nfts = append(nfts, nft)
 	}
 	
 	return nfts
 }
+
+// BurnTweetNFT deletes the nft along with its content hash, its expired license grants and its
+// count towards the profile of its handle, nfts with active licenses or editions can not be burned.
+func (keeper Keeper) BurnTweetNFT(ctx sdk.Context, nft types.BaseTweetNFT, owner sdk.AccAddress) error {
+	grants := keeper.GetLicenseGrants(ctx, nft.PrimaryNFTID)
+	for _, grant := range grants {
+		if grant.IsActive() {
+			return sdkerrors.Wrapf(types.ErrInvalidLicense, "%s is licensed to %s", nft.PrimaryNFTID, grant.Licensee)
+		}
+	}
+	
+	if nft.IsMaster() && len(keeper.GetEditionsOfMaster(ctx, nft.PrimaryNFTID)) > 0 {
+		return sdkerrors.Wrapf(types.ErrInvalidEdition, "editions of %s are minted", nft.PrimaryNFTID)
+	}
+	
+	if keeper.hooks != nil {
+		if err := keeper.hooks.BeforeNFTBurn(ctx, nft.PrimaryNFTID); err != nil {
+			return err
+		}
+	}
+	
+	keeper.RemoveTweetIDFromAccount(ctx, owner, nft.PrimaryNFTID)
+	keeper.DeleteNFTApproval(ctx, nft.PrimaryNFTID)
+	
+	if id, found := keeper.GetNFTIDByContentHash(ctx, nft.Metadata.ContentHash); found && id == nft.PrimaryNFTID {
+		keeper.DeleteContentHashNFT(ctx, nft.Metadata.ContentHash)
+	}
+	
+	for _, grant := range grants {
+		keeper.DeleteLicenseGrant(ctx, grant.PrimaryNFTID, grant.Channel, grant.SecondaryNFTID)
+	}
+	keeper.UnlinkProfile(ctx, nft)
+	
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.GetTweetNFTKey([]byte(nft.PrimaryNFTID)))
+	return nil
+}
+
+// MigratePlatforms assigns the default platform to the nfts minted before platforms were
+// introduced, all of which were minted from tweets.
+func (keeper Keeper) MigratePlatforms(ctx sdk.Context) {
+	store := ctx.KVStore(keeper.storeKey)
+	
+	iterator := sdk.KVStorePrefixIterator(store, types.TweetNFTPrefix)
+	defer iterator.Close()
+	
+	var nfts []types.BaseTweetNFT
+	for ; iterator.Valid(); iterator.Next() {
+		var nft types.BaseTweetNFT
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &nft)
+		if nft.Platform == "" {
+			nft.Platform = types.DefaultPlatform
+			nfts = append(nfts, nft)
+		}
+	}
+	
+	for _, nft := range nfts {
+		keeper.MintTweetNFT(ctx, nft)
+	}
+}
+
+// MigrateLicenses gives the default license terms to the nfts flagged as licensable before license
+// terms were introduced.
+func (keeper Keeper) MigrateLicenses(ctx sdk.Context) {
+	store := ctx.KVStore(keeper.storeKey)
+	
+	iterator := sdk.KVStorePrefixIterator(store, types.TweetNFTPrefix)
+	defer iterator.Close()
+	
+	var nfts []types.BaseTweetNFT
+	for ; iterator.Valid(); iterator.Next() {
+		var nft types.BaseTweetNFT
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &nft)
+		if nft.License {
+			if nft.LicenseTerms == nil {
+				terms := types.DefaultLicenseTerms()
+				nft.LicenseTerms = &terms
+			}
+			nft.License = false
+			nfts = append(nfts, nft)
+		}
+	}
+	
+	for _, nft := range nfts {
+		keeper.MintTweetNFT(ctx, nft)
+	}
+}
@@ -0,0 +1,65 @@
+package keeper_test
+
+import (
+	"testing"
+	
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	
+	"github.com/FreeFlixMedia/modules/internal/testutil"
+	"github.com/FreeFlixMedia/modules/nfts/internal/keeper"
+	"github.com/FreeFlixMedia/modules/nfts/internal/types"
+)
+
+func setupKeeper(t *testing.T, chain string) (sdk.Context, keeper.Keeper, testutil.BankKeeper) {
+	cdc := codec.New()
+	sdk.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+	types.RegisterCodec(cdc)
+	
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.NewContext(t, chain, key)
+	bank := testutil.NewBankKeeper()
+	
+	k := keeper.NewKeeper(cdc, key, bank, testutil.NewSubspace(cdc, types.ModuleName))
+	k.SetParams(ctx, types.DefaultParams())
+	return ctx, k, bank
+}
+
+// mintNFT mints a primary nft of the creator with a royalty of rate and hands it to the owner.
+func mintNFT(t *testing.T, ctx sdk.Context, k keeper.Keeper, creator, owner sdk.AccAddress, rate sdk.Dec) types.BaseTweetNFT {
+	msg := types.NewMsgMintNFT(creator, "", testutil.NewAddr().String(), nil, testutil.Coin(10), sdk.NewDecWithPrec(5, 1),
+		0, 0, rate, nil, types.PlatformTwitter, "creator", types.Metadata{})
+	royaltyRate, err := k.ValidateMint(ctx, msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	
+	nft := k.MintNewTweetNFT(ctx, msg, royaltyRate)
+	if !owner.Equals(creator) {
+		k.TransferTweetNFT(ctx, nft, creator, owner)
+		nft, _ = k.GetTweetNFTByID(ctx, nft.PrimaryNFTID)
+	}
+	return nft
+}
+
+func TestMigrateLicenses(t *testing.T) {
+	ctx, k, _ := setupKeeper(t, types.FreeFlixContext)
+	
+	owner := testutil.NewAddr()
+	legacy := mintNFT(t, ctx, k, owner, owner, sdk.ZeroDec())
+	legacy.License = true
+	k.MintTweetNFT(ctx, legacy)
+	unlicensed := mintNFT(t, ctx, k, owner, owner, sdk.ZeroDec())
+	
+	k.MigrateLicenses(ctx)
+	
+	if nft, _ := k.GetTweetNFTByID(ctx, legacy.PrimaryNFTID); !nft.IsLicensable() {
+		t.Fatal("nfts flagged as licensable should get the default license terms")
+	} else if nft.License {
+		t.Fatal("the legacy license flag should be cleared")
+	}
+	if nft, _ := k.GetTweetNFTByID(ctx, unlicensed.PrimaryNFTID); nft.IsLicensable() {
+		t.Fatal("nfts not flagged as licensable should stay unlicensable")
+	}
+}
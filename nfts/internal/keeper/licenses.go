@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	
+	"github.com/FreeFlixMedia/modules/nfts/internal/types"
+)
+
+func (keeper Keeper) SetLicenseGrant(ctx sdk.Context, grant types.LicenseGrant) {
+	store := ctx.KVStore(keeper.storeKey)
+	
+	key := types.GetLicenseGrantKey(grant.PrimaryNFTID, grant.Channel, grant.SecondaryNFTID)
+	store.Set(key, keeper.cdc.MustMarshalBinaryLengthPrefixed(grant))
+}
+
+func (keeper Keeper) GetLicenseGrant(ctx sdk.Context, primaryNFTID, channel, secondaryNFTID string) (types.LicenseGrant, bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	
+	bz := store.Get(types.GetLicenseGrantKey(primaryNFTID, channel, secondaryNFTID))
+	if bz == nil {
+		return types.LicenseGrant{}, false
+	}
+	
+	var grant types.LicenseGrant
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &grant)
+	return grant, true
+}
+
+func (keeper Keeper) DeleteLicenseGrant(ctx sdk.Context, primaryNFTID, channel, secondaryNFTID string) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.GetLicenseGrantKey(primaryNFTID, channel, secondaryNFTID))
+}
+
+func (keeper Keeper) GetLicenseGrants(ctx sdk.Context, primaryNFTID string) []types.LicenseGrant {
+	store := ctx.KVStore(keeper.storeKey)
+	
+	iterator := sdk.KVStorePrefixIterator(store, types.GetLicenseGrantsKey(primaryNFTID))
+	defer iterator.Close()
+	
+	grants := []types.LicenseGrant{}
+	for ; iterator.Valid(); iterator.Next() {
+		var grant types.LicenseGrant
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &grant)
+		grants = append(grants, grant)
+	}
+	
+	return grants
+}
+
+func (keeper Keeper) GetAllLicenseGrants(ctx sdk.Context) []types.LicenseGrant {
+	store := ctx.KVStore(keeper.storeKey)
+	
+	iterator := sdk.KVStorePrefixIterator(store, types.LicenseGrantPrefix)
+	defer iterator.Close()
+	
+	var grants []types.LicenseGrant
+	for ; iterator.Valid(); iterator.Next() {
+		var grant types.LicenseGrant
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &grant)
+		grants = append(grants, grant)
+	}
+	
+	return grants
+}
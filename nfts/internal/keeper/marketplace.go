@@ -0,0 +1,308 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	
+	"github.com/FreeFlixMedia/modules/nfts/internal/types"
+)
+
+func (keeper Keeper) SetListing(ctx sdk.Context, listing types.Listing) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.GetListingKey(listing.PrimaryNFTID), keeper.cdc.MustMarshalBinaryLengthPrefixed(listing))
+}
+
+func (keeper Keeper) GetListing(ctx sdk.Context, primaryNFTID string) (types.Listing, bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	
+	bz := store.Get(types.GetListingKey(primaryNFTID))
+	if bz == nil {
+		return types.Listing{}, false
+	}
+	
+	var listing types.Listing
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &listing)
+	return listing, true
+}
+
+func (keeper Keeper) DeleteListing(ctx sdk.Context, primaryNFTID string) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.GetListingKey(primaryNFTID))
+}
+
+// SetEscrow records the seller of a listed or auctioned nft held by the module account.
+func (keeper Keeper) SetEscrow(ctx sdk.Context, primaryNFTID string, seller sdk.AccAddress) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.GetEscrowKey(primaryNFTID), seller)
+}
+
+func (keeper Keeper) GetEscrowSeller(ctx sdk.Context, primaryNFTID string) (sdk.AccAddress, bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	
+	bz := store.Get(types.GetEscrowKey(primaryNFTID))
+	if bz == nil {
+		return nil, false
+	}
+	return sdk.AccAddress(bz), true
+}
+
+func (keeper Keeper) DeleteEscrow(ctx sdk.Context, primaryNFTID string) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.GetEscrowKey(primaryNFTID))
+}
+
+func (keeper Keeper) IsEscrowed(ctx sdk.Context, primaryNFTID string) bool {
+	store := ctx.KVStore(keeper.storeKey)
+	return store.Has(types.GetEscrowKey(primaryNFTID))
+}
+
+func (keeper Keeper) GetAllListings(ctx sdk.Context) []types.Listing {
+	store := ctx.KVStore(keeper.storeKey)
+	
+	iterator := sdk.KVStorePrefixIterator(store, types.ListingPrefix)
+	defer iterator.Close()
+	
+	listings := make([]types.Listing, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var listing types.Listing
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &listing)
+		listings = append(listings, listing)
+	}
+	
+	return listings
+}
+
+// EscrowTweetNFT moves a listed or auctioned nft from the seller to the module account until
+// the sale settles, its revenue splits are kept for the seller.
+func (keeper Keeper) EscrowTweetNFT(ctx sdk.Context, nft types.BaseTweetNFT, seller sdk.AccAddress) {
+	keeper.RemoveTweetIDFromAccount(ctx, seller, nft.PrimaryNFTID)
+	keeper.SetTweetIDToAccount(ctx, types.ModuleAddress, nft.PrimaryNFTID)
+	keeper.SetEscrow(ctx, nft.PrimaryNFTID, seller)
+	
+	nft.PrimaryOwner = types.ModuleAddress.String()
+	keeper.MintTweetNFT(ctx, nft)
+}
+
+// ReleaseTweetNFT hands an escrowed nft back to its seller once the sale is called off.
+func (keeper Keeper) ReleaseTweetNFT(ctx sdk.Context, primaryNFTID string) error {
+	nft, found := keeper.GetTweetNFTByID(ctx, primaryNFTID)
+	if !found {
+		return sdkerrors.Wrap(types.ErrNFTNotFound, primaryNFTID)
+	}
+	
+	seller, found := keeper.GetEscrowSeller(ctx, primaryNFTID)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s is not escrowed", primaryNFTID)
+	}
+	
+	keeper.RemoveTweetIDFromAccount(ctx, types.ModuleAddress, primaryNFTID)
+	keeper.SetTweetIDToAccount(ctx, seller, primaryNFTID)
+	keeper.DeleteEscrow(ctx, primaryNFTID)
+	
+	nft.PrimaryOwner = seller.String()
+	keeper.MintTweetNFT(ctx, nft)
+	return nil
+}
+
+// TransferTweetNFT reassigns the primary owner and moves the nft between the owner indexes. The
+// revenue splits of the previous owner are cleared, the new owner sets its own.
+func (keeper Keeper) TransferTweetNFT(ctx sdk.Context, nft types.BaseTweetNFT, from, to sdk.AccAddress) {
+	keeper.RemoveTweetIDFromAccount(ctx, from, nft.PrimaryNFTID)
+	keeper.SetTweetIDToAccount(ctx, to, nft.PrimaryNFTID)
+	keeper.DeleteNFTApproval(ctx, nft.PrimaryNFTID)
+	
+	nft.PrimaryOwner = to.String()
+	nft.RevenueSplits = nil
+	keeper.MintTweetNFT(ctx, nft)
+}
+
+// TransferOwnedTweetNFT hands a primary nft to the recipient on behalf of its owner, co-owned
+// nfts are only transferred through proposals.
+func (keeper Keeper) TransferOwnedTweetNFT(ctx sdk.Context, msg types.MsgTransferTweetNFT) (sdk.AccAddress, error) {
+	if types.GetContextOfCurrentChain() != types.FreeFlixContext {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "only primary nfts can be transferred")
+	}
+	
+	nft, found := keeper.GetTweetNFTByID(ctx, msg.PrimaryNFTID)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrNFTNotFound, msg.PrimaryNFTID)
+	}
+	
+	if !keeper.IsOwnerOrApproved(ctx, nft, msg.Sender) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only primary owner or approved can transfer nft")
+	} else if nft.IsCoOwned() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is co-owned", nft.PrimaryNFTID)
+	} else if keeper.IsEscrowed(ctx, nft.PrimaryNFTID) {
+		return nil, sdkerrors.Wrap(types.ErrNFTEscrowed, nft.PrimaryNFTID)
+	}
+	
+	owner, err := sdk.AccAddressFromBech32(nft.PrimaryOwner)
+	if err != nil {
+		return nil, err
+	} else if owner.Equals(msg.Recipient) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "recipient already owns nft")
+	}
+	
+	keeper.TransferTweetNFT(ctx, nft, owner, msg.Recipient)
+	return owner, nil
+}
+
+// ListTweetNFT lists a primary nft on behalf of its owner, the owner is the seller paid for it.
+func (keeper Keeper) ListTweetNFT(ctx sdk.Context, sender sdk.AccAddress, primaryNFTID string, price sdk.Coin) (types.Listing, error) {
+	if types.GetContextOfCurrentChain() != types.FreeFlixContext {
+		return types.Listing{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "only primary nfts can be listed")
+	}
+	
+	nft, found := keeper.GetTweetNFTByID(ctx, primaryNFTID)
+	if !found {
+		return types.Listing{}, sdkerrors.Wrap(types.ErrNFTNotFound, primaryNFTID)
+	}
+	
+	if _, found := keeper.GetListing(ctx, primaryNFTID); found {
+		return types.Listing{}, sdkerrors.Wrap(types.ErrListingAlreadyExists, primaryNFTID)
+	} else if keeper.IsEscrowed(ctx, primaryNFTID) {
+		return types.Listing{}, sdkerrors.Wrap(types.ErrNFTEscrowed, primaryNFTID)
+	}
+	
+	if !keeper.IsOwnerOrApproved(ctx, nft, sender) {
+		return types.Listing{}, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only primary owner or approved can list nft")
+	} else if nft.IsCoOwned() {
+		return types.Listing{}, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is co-owned", nft.PrimaryNFTID)
+	} else if keeper.HasActiveLicenseAuction(ctx, primaryNFTID) {
+		return types.Listing{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "the license of %s is being auctioned", primaryNFTID)
+	}
+	
+	seller, err := sdk.AccAddressFromBech32(nft.PrimaryOwner)
+	if err != nil {
+		return types.Listing{}, err
+	}
+	
+	listing := types.NewListing(primaryNFTID, nft.PrimaryOwner, price)
+	keeper.EscrowTweetNFT(ctx, nft, seller)
+	keeper.SetListing(ctx, listing)
+	return listing, nil
+}
+
+func (keeper Keeper) DelistTweetNFT(ctx sdk.Context, sender sdk.AccAddress, primaryNFTID string) (types.Listing, error) {
+	listing, found := keeper.GetListing(ctx, primaryNFTID)
+	if !found {
+		return types.Listing{}, sdkerrors.Wrap(types.ErrListingNotFound, primaryNFTID)
+	}
+	
+	nft, found := keeper.GetTweetNFTByID(ctx, primaryNFTID)
+	if !found {
+		return types.Listing{}, sdkerrors.Wrap(types.ErrNFTNotFound, primaryNFTID)
+	}
+	
+	// the escrowed nft is delisted on behalf of the seller
+	nft.PrimaryOwner = listing.Seller
+	if listing.Seller != sender.String() && !keeper.IsOwnerOrApproved(ctx, nft, sender) {
+		return types.Listing{}, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only seller or approved can delist nft")
+	}
+	
+	if err := keeper.ReleaseTweetNFT(ctx, primaryNFTID); err != nil {
+		return types.Listing{}, err
+	}
+	keeper.DeleteListing(ctx, primaryNFTID)
+	return listing, nil
+}
+
+// PayForTweetNFT settles a paid transfer of a primary nft, the creator royalty is routed to the
+// payout address of the creator and the rest of the price goes to the seller.
+func (keeper Keeper) PayForTweetNFT(ctx sdk.Context, nft types.BaseTweetNFT, buyer, seller sdk.AccAddress, price sdk.Coin) (sdk.Coin, error) {
+	royalty := nft.Royalty(price, seller.String(), keeper.GetParams(ctx).MaxRoyaltyRate)
+	if royalty.IsPositive() {
+		creator, err := keeper.CreatorPayoutAddress(ctx, nft)
+		if err != nil {
+			return royalty, err
+		}
+		
+		if err := keeper.bankKeeper.SendCoins(ctx, buyer, creator, sdk.Coins{royalty}); err != nil {
+			return royalty, err
+		}
+	}
+	
+	if proceeds := price.Sub(royalty); proceeds.IsPositive() {
+		if err := keeper.bankKeeper.SendCoins(ctx, buyer, seller, sdk.Coins{proceeds}); err != nil {
+			return royalty, err
+		}
+	}
+	
+	return royalty, nil
+}
+
+// BuyTweetNFT pays the seller and hands the escrowed nft to the buyer, the price has to match
+// the listing so the buyer never pays more than expected.
+func (keeper Keeper) BuyTweetNFT(ctx sdk.Context, buyer sdk.AccAddress, primaryNFTID string, price sdk.Coin) (types.Listing, sdk.Coin, error) {
+	listing, found := keeper.GetListing(ctx, primaryNFTID)
+	if !found {
+		return types.Listing{}, sdk.Coin{}, sdkerrors.Wrap(types.ErrListingNotFound, primaryNFTID)
+	}
+	
+	if listing.Seller == buyer.String() {
+		return types.Listing{}, sdk.Coin{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "seller can not buy own listing")
+	} else if !listing.Price.IsEqual(price) {
+		return types.Listing{}, sdk.Coin{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "listing price is %s", listing.Price)
+	}
+	
+	nft, found := keeper.GetTweetNFTByID(ctx, primaryNFTID)
+	if !found {
+		return types.Listing{}, sdk.Coin{}, sdkerrors.Wrap(types.ErrNFTNotFound, primaryNFTID)
+	}
+	
+	seller, err := sdk.AccAddressFromBech32(listing.Seller)
+	if err != nil {
+		return types.Listing{}, sdk.Coin{}, err
+	}
+	
+	royalty, err := keeper.PayForTweetNFT(ctx, nft, buyer, seller, listing.Price)
+	if err != nil {
+		return types.Listing{}, sdk.Coin{}, err
+	}
+	
+	keeper.TransferTweetNFT(ctx, nft, types.ModuleAddress, buyer)
+	keeper.DeleteEscrow(ctx, primaryNFTID)
+	keeper.DeleteListing(ctx, primaryNFTID)
+	return listing, royalty, nil
+}
+
+// Payouts splits a revenue of the nft across its revenue splits, the revenue of an escrowed nft
+// goes to its seller until the sale settles. The part owed to the creator goes to its payout address.
+func (keeper Keeper) Payouts(ctx sdk.Context, nft types.BaseTweetNFT, amount sdk.Coin) ([]types.Payout, error) {
+	if seller, found := keeper.GetEscrowSeller(ctx, nft.PrimaryNFTID); found {
+		nft.PrimaryOwner = seller.String()
+	}
+	
+	payouts, err := nft.Payouts(amount)
+	if err != nil {
+		return nil, err
+	}
+	
+	for i, payout := range payouts {
+		if nft.Creator == "" || payout.Address.String() != nft.Creator {
+			continue
+		}
+		if payouts[i].Address, err = keeper.CreatorPayoutAddress(ctx, nft); err != nil {
+			return nil, err
+		}
+	}
+	return payouts, nil
+}
+
+// DistributeRevenue pays a revenue of the nft from the given account across its revenue splits.
+func (keeper Keeper) DistributeRevenue(ctx sdk.Context, nft types.BaseTweetNFT, from sdk.AccAddress, amount sdk.Coin) error {
+	payouts, err := keeper.Payouts(ctx, nft, amount)
+	if err != nil {
+		return err
+	}
+	
+	for _, payout := range payouts {
+		if !payout.Amount.IsPositive() {
+			continue
+		}
+		if err := keeper.bankKeeper.SendCoins(ctx, from, payout.Address, sdk.Coins{payout.Amount}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
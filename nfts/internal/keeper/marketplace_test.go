@@ -0,0 +1,170 @@
+package keeper_test
+
+import (
+	"testing"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	
+	"github.com/FreeFlixMedia/modules/internal/testutil"
+	"github.com/FreeFlixMedia/modules/nfts/internal/types"
+)
+
+func TestBuyTweetNFT(t *testing.T) {
+	ctx, k, bank := setupKeeper(t, types.FreeFlixContext)
+	
+	creator, seller, buyer := testutil.NewAddr(), testutil.NewAddr(), testutil.NewAddr()
+	nft := mintNFT(t, ctx, k, creator, seller, sdk.NewDecWithPrec(5, 2))
+	bank.SetBalance(buyer, 1000)
+	
+	if _, err := k.ListTweetNFT(ctx, seller, nft.PrimaryNFTID, testutil.Coin(400)); err != nil {
+		t.Fatal(err)
+	}
+	
+	listed, _ := k.GetTweetNFTByID(ctx, nft.PrimaryNFTID)
+	if listed.PrimaryOwner != types.ModuleAddress.String() {
+		t.Fatalf("listed nft should be held by the module account, owned by %s", listed.PrimaryOwner)
+	} else if escrowed, _ := k.GetEscrowSeller(ctx, nft.PrimaryNFTID); !escrowed.Equals(seller) {
+		t.Fatal("listed nft should be escrowed for the seller")
+	}
+	
+	if _, _, err := k.BuyTweetNFT(ctx, buyer, nft.PrimaryNFTID, testutil.Coin(300)); err == nil {
+		t.Fatal("buying below the listing price should fail")
+	}
+	
+	_, royalty, err := k.BuyTweetNFT(ctx, buyer, nft.PrimaryNFTID, testutil.Coin(400))
+	if err != nil {
+		t.Fatal(err)
+	} else if !royalty.IsEqual(testutil.Coin(20)) {
+		t.Fatalf("expected royalty of 20%s, got %s", testutil.Denom, royalty)
+	}
+	
+	testutil.RequireBalance(t, bank, buyer, 600)
+	testutil.RequireBalance(t, bank, creator, 20)
+	testutil.RequireBalance(t, bank, seller, 380)
+	
+	bought, _ := k.GetTweetNFTByID(ctx, nft.PrimaryNFTID)
+	if bought.PrimaryOwner != buyer.String() {
+		t.Fatalf("bought nft should be owned by the buyer, owned by %s", bought.PrimaryOwner)
+	} else if k.IsEscrowed(ctx, nft.PrimaryNFTID) {
+		t.Fatal("bought nft should no longer be escrowed")
+	} else if _, found := k.GetListing(ctx, nft.PrimaryNFTID); found {
+		t.Fatal("listing should be removed once bought")
+	}
+}
+
+func TestBuyTweetNFTWithoutFunds(t *testing.T) {
+	ctx, k, bank := setupKeeper(t, types.FreeFlixContext)
+	
+	seller, buyer := testutil.NewAddr(), testutil.NewAddr()
+	nft := mintNFT(t, ctx, k, seller, seller, sdk.ZeroDec())
+	bank.SetBalance(buyer, 100)
+	
+	if _, err := k.ListTweetNFT(ctx, seller, nft.PrimaryNFTID, testutil.Coin(400)); err != nil {
+		t.Fatal(err)
+	}
+	
+	cacheCtx, _ := ctx.CacheContext()
+	if _, _, err := k.BuyTweetNFT(cacheCtx, buyer, nft.PrimaryNFTID, testutil.Coin(400)); err == nil {
+		t.Fatal("buying without funds should fail")
+	}
+	
+	testutil.RequireBalance(t, bank, buyer, 100)
+	testutil.RequireBalance(t, bank, seller, 0)
+	if _, found := k.GetListing(ctx, nft.PrimaryNFTID); !found {
+		t.Fatal("listing should remain after a failed buy")
+	}
+}
+
+func TestDelistTweetNFT(t *testing.T) {
+	ctx, k, _ := setupKeeper(t, types.FreeFlixContext)
+	
+	seller, other := testutil.NewAddr(), testutil.NewAddr()
+	nft := mintNFT(t, ctx, k, seller, seller, sdk.ZeroDec())
+	nft.RevenueSplits = []types.RevenueSplit{{Address: other.String(), Weight: sdk.NewDecWithPrec(5, 1)}}
+	k.MintTweetNFT(ctx, nft)
+	
+	if _, err := k.ListTweetNFT(ctx, seller, nft.PrimaryNFTID, testutil.Coin(400)); err != nil {
+		t.Fatal(err)
+	}
+	
+	if _, err := k.DelistTweetNFT(ctx, other, nft.PrimaryNFTID); err == nil {
+		t.Fatal("only the seller or an approved address should delist")
+	}
+	
+	if _, err := k.DelistTweetNFT(ctx, seller, nft.PrimaryNFTID); err != nil {
+		t.Fatal(err)
+	} else if k.IsEscrowed(ctx, nft.PrimaryNFTID) {
+		t.Fatal("delisted nft should no longer be escrowed")
+	}
+	
+	delisted, _ := k.GetTweetNFTByID(ctx, nft.PrimaryNFTID)
+	if delisted.PrimaryOwner != seller.String() {
+		t.Fatalf("delisted nft should be back with the seller, owned by %s", delisted.PrimaryOwner)
+	} else if len(delisted.RevenueSplits) != 1 {
+		t.Fatal("delisted nft should keep the revenue splits of the seller")
+	} else if len(k.GetTweetsOfAccount(ctx, seller)) != 1 {
+		t.Fatal("delisted nft should be back in the owner index of the seller")
+	}
+}
+
+func TestEscrowedRevenueGoesToSeller(t *testing.T) {
+	ctx, k, bank := setupKeeper(t, types.FreeFlixContext)
+	
+	seller, payer := testutil.NewAddr(), testutil.NewAddr()
+	nft := mintNFT(t, ctx, k, seller, seller, sdk.ZeroDec())
+	bank.SetBalance(payer, 100)
+	
+	if _, err := k.ListTweetNFT(ctx, seller, nft.PrimaryNFTID, testutil.Coin(400)); err != nil {
+		t.Fatal(err)
+	}
+	
+	listed, _ := k.GetTweetNFTByID(ctx, nft.PrimaryNFTID)
+	if err := k.DistributeRevenue(ctx, listed, payer, testutil.Coin(100)); err != nil {
+		t.Fatal(err)
+	}
+	testutil.RequireBalance(t, bank, seller, 100)
+	testutil.RequireBalance(t, bank, types.ModuleAddress, 0)
+}
+
+func TestDistributeRevenue(t *testing.T) {
+	ctx, k, bank := setupKeeper(t, types.FreeFlixContext)
+	
+	owner, payer, collaborator := testutil.NewAddr(), testutil.NewAddr(), testutil.NewAddr()
+	nft := mintNFT(t, ctx, k, owner, owner, sdk.ZeroDec())
+	nft.RevenueSplits = []types.RevenueSplit{
+		types.NewRevenueSplit(owner.String(), sdk.NewDecWithPrec(7, 1)),
+		types.NewRevenueSplit(collaborator.String(), sdk.NewDecWithPrec(3, 1)),
+	}
+	bank.SetBalance(payer, 101)
+	
+	if err := k.DistributeRevenue(ctx, nft, payer, testutil.Coin(101)); err != nil {
+		t.Fatal(err)
+	}
+	
+	// the rounding dust goes to the first collaborator
+	testutil.RequireBalance(t, bank, owner, 71)
+	testutil.RequireBalance(t, bank, collaborator, 30)
+	testutil.RequireBalance(t, bank, payer, 0)
+}
+
+func TestTransferClearsRevenueSplits(t *testing.T) {
+	ctx, k, _ := setupKeeper(t, types.FreeFlixContext)
+	
+	owner, collaborator, recipient := testutil.NewAddr(), testutil.NewAddr(), testutil.NewAddr()
+	nft := mintNFT(t, ctx, k, owner, owner, sdk.ZeroDec())
+	
+	nft.RevenueSplits = []types.RevenueSplit{
+		types.NewRevenueSplit(owner.String(), sdk.NewDecWithPrec(5, 1)),
+		types.NewRevenueSplit(collaborator.String(), sdk.NewDecWithPrec(5, 1)),
+	}
+	k.MintTweetNFT(ctx, nft)
+	
+	if _, err := k.TransferOwnedTweetNFT(ctx, types.NewMsgTransferTweetNFT(owner, nft.PrimaryNFTID, recipient)); err != nil {
+		t.Fatal(err)
+	}
+	
+	transferred, _ := k.GetTweetNFTByID(ctx, nft.PrimaryNFTID)
+	if len(transferred.RevenueSplits) != 0 {
+		t.Fatalf("the splits of the previous owner should be cleared, got %v", transferred.RevenueSplits)
+	}
+}
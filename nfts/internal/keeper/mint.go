@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"strings"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	
+	"github.com/FreeFlixMedia/modules/nfts/internal/types"
+)
+
+// ValidateMint checks the mint against the state of the chain and returns the royalty rate the
+// nft is minted with.
+func (keeper Keeper) ValidateMint(ctx sdk.Context, msg types.MsgMintTweetNFT) (sdk.Dec, error) {
+	if msg.CollectionID != "" {
+		collection, found := keeper.GetCollection(ctx, msg.CollectionID)
+		if !found {
+			return sdk.Dec{}, sdkerrors.Wrap(types.ErrCollectionNotFound, msg.CollectionID)
+		} else if !collection.CanMint(msg.Sender.String()) {
+			return sdk.Dec{}, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s can not mint into collection %s",
+				msg.Sender, msg.CollectionID)
+		}
+	}
+	
+	platform := types.NormalizePlatform(msg.Platform)
+	if err := keeper.ValidateHandleAttestation(ctx, platform, types.NormalizeHandle(platform, msg.TwitterHandle), msg.Sender); err != nil {
+		return sdk.Dec{}, err
+	}
+	
+	if hash := msg.Metadata.ContentHash; hash != "" {
+		if id, found := keeper.GetNFTIDByContentHash(ctx, hash); found {
+			return sdk.Dec{}, sdkerrors.Wrapf(types.ErrContentHashAlreadyExist, "%s is minted as %s", hash, id)
+		}
+	}
+	
+	nfts := keeper.GetTweetsOfAccount(ctx, msg.Sender)
+	
+	for _, nft := range nfts {
+		// editions share the asset of their master, only masters and single nfts hold it
+		if !nft.IsEdition() && strings.EqualFold(nft.AssetID, msg.AssetID) {
+			return sdk.Dec{}, sdkerrors.Wrap(types.ErrAssetIDAlreadyExist, "")
+		}
+	}
+	
+	royaltyRate := msg.RoyaltyRate
+	if royaltyRate.IsNil() {
+		royaltyRate = sdk.ZeroDec()
+	} else if maxRate := keeper.GetParams(ctx).MaxRoyaltyRate; royaltyRate.GT(maxRate) {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrInvalidRoyaltyRate, "royalty rate can not exceed %s", maxRate)
+	}
+	return royaltyRate, nil
+}
+
+// MintNewTweetNFT mints the nft of a validated mint to its sender under the next global count.
+func (keeper Keeper) MintNewTweetNFT(ctx sdk.Context, msg types.MsgMintTweetNFT, royaltyRate sdk.Dec) types.BaseTweetNFT {
+	count := keeper.GetGlobalTweetCount(ctx)
+	id := types.GetPrimaryNFTID(count)
+	if msg.CollectionID != "" {
+		id = types.GetCollectionNFTID(msg.CollectionID, count)
+	}
+	platform := types.NormalizePlatform(msg.Platform)
+	tweetNFT := types.BaseTweetNFT{
+		PrimaryNFTID:   id,
+		PrimaryOwner:   msg.Sender.String(),
+		Creator:        msg.Sender.String(),
+		RoyaltyRate:    royaltyRate,
+		SecondaryNFTID: "",
+		SecondaryOwner: "",
+		LicenseTerms:   msg.LicenseTerms,
+		AssetID:        msg.AssetID,
+		LicensingFee:   msg.LicensingFee,
+		RevenueShare:   msg.RevenueShare,
+		RevenueSplits:  msg.RevenueSplits,
+		MaxLicensees:   msg.MaxLicensees,
+		TwitterHandle:  types.NormalizeHandle(platform, msg.TwitterHandle),
+		Metadata:       msg.Metadata,
+		Platform:       platform,
+		CollectionID:   msg.CollectionID,
+		MaxEditions:    msg.MaxEditions,
+	}
+	
+	keeper.MintTweetNFT(ctx, tweetNFT)
+	keeper.SetTweetIDToAccount(ctx, msg.Sender, tweetNFT.PrimaryNFTID)
+	keeper.SetGlobalTweetCount(ctx, count+1)
+	if tweetNFT.Metadata.ContentHash != "" {
+		keeper.SetContentHashNFT(ctx, tweetNFT.Metadata.ContentHash, tweetNFT.PrimaryNFTID)
+	}
+	keeper.LinkProfile(ctx, tweetNFT)
+	return tweetNFT
+}
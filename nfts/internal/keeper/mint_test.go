@@ -0,0 +1,48 @@
+package keeper_test
+
+import (
+	"testing"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	
+	"github.com/FreeFlixMedia/modules/internal/testutil"
+	"github.com/FreeFlixMedia/modules/nfts/internal/types"
+)
+
+func mintEntry(assetID string) types.MintEntry {
+	return types.MintEntry{AssetID: assetID, LicensingFee: testutil.Coin(10), RevenueShare: sdk.NewDecWithPrec(5, 1),
+		RoyaltyRate: sdk.ZeroDec(), TwitterHandle: "creator", Platform: types.PlatformGeneric}
+}
+
+func TestBatchMintEntries(t *testing.T) {
+	ctx, k, _ := setupKeeper(t, types.FreeFlixContext)
+	
+	sender := testutil.NewAddr()
+	minted := mintNFT(t, ctx, k, sender, sender, sdk.ZeroDec())
+	
+	repeated := types.NewMsgBatchMintTweetNFTs(sender, []types.MintEntry{mintEntry("asset"), mintEntry("ASSET")})
+	if err := repeated.ValidateBasic(); err == nil {
+		t.Fatal("a batch repeating an asset id should be rejected")
+	}
+	
+	batch := types.NewMsgBatchMintTweetNFTs(sender, []types.MintEntry{mintEntry("first"), mintEntry(minted.AssetID)})
+	if err := batch.ValidateBasic(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := k.ValidateMint(ctx, batch.Entries[1].ToMsgMintTweetNFT(sender)); err == nil {
+		t.Fatal("an entry repeating an asset id the sender already minted should be rejected")
+	}
+	
+	count := k.GetGlobalTweetCount(ctx)
+	for _, entry := range []types.MintEntry{mintEntry("first"), mintEntry("second")} {
+		msg := entry.ToMsgMintTweetNFT(sender)
+		royaltyRate, err := k.ValidateMint(ctx, msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if nft := k.MintNewTweetNFT(ctx, msg, royaltyRate); nft.PrimaryNFTID != types.GetPrimaryNFTID(count) {
+			t.Fatalf("entries should be minted under consecutive ids, got %s", nft.PrimaryNFTID)
+		}
+		count++
+	}
+}
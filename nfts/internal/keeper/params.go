@@ -0,0 +1,16 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	
+	"github.com/FreeFlixMedia/modules/nfts/internal/types"
+)
+
+func (keeper Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	keeper.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+func (keeper Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	keeper.paramSpace.SetParamSet(ctx, &params)
+}
@@ -0,0 +1,154 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	
+	"github.com/FreeFlixMedia/modules/nfts/internal/types"
+)
+
+func (keeper Keeper) SetProfile(ctx sdk.Context, profile types.Profile) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.GetProfileKey(profile.Platform, profile.Handle), keeper.cdc.MustMarshalBinaryLengthPrefixed(profile))
+}
+
+func (keeper Keeper) GetProfile(ctx sdk.Context, platform, handle string) (types.Profile, bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	
+	bz := store.Get(types.GetProfileKey(platform, handle))
+	if bz == nil {
+		return types.Profile{}, false
+	}
+	
+	var profile types.Profile
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &profile)
+	return profile, true
+}
+
+func (keeper Keeper) GetAllProfiles(ctx sdk.Context) []types.Profile {
+	store := ctx.KVStore(keeper.storeKey)
+	
+	iterator := sdk.KVStorePrefixIterator(store, types.ProfilePrefix)
+	defer iterator.Close()
+	
+	profiles := make([]types.Profile, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var profile types.Profile
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &profile)
+		profiles = append(profiles, profile)
+	}
+	
+	return profiles
+}
+
+// UpdateProfile sets the profile fields of the handle for the sender. A profile owned by another
+// address can only be taken over with an attestation of the handle to the sender.
+func (keeper Keeper) UpdateProfile(ctx sdk.Context, msg types.MsgSetProfile) (types.Profile, error) {
+	attested := keeper.CheckHandleAttestation(ctx, msg.Platform, msg.Handle, msg.Sender) == nil
+	if err := keeper.ValidateHandleAttestation(ctx, msg.Platform, msg.Handle, msg.Sender); err != nil {
+		return types.Profile{}, err
+	}
+	
+	profile, found := keeper.GetProfile(ctx, msg.Platform, msg.Handle)
+	if !found {
+		profile = types.NewProfile(msg.Platform, msg.Handle, msg.Sender.String())
+	} else if profile.Owner != msg.Sender.String() && !attested {
+		return types.Profile{}, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "profile of %s handle %s is owned by %s",
+			msg.Platform, msg.Handle, profile.Owner)
+	}
+	
+	profile.Owner = msg.Sender.String()
+	profile.DisplayName = msg.DisplayName
+	profile.AvatarURI = msg.AvatarURI
+	profile.PayoutAddress = msg.PayoutAddress
+	profile.Verified = attested
+	keeper.SetProfile(ctx, profile)
+	return profile, nil
+}
+
+// LinkProfile counts an nft minted by the creator towards the profile of its handle, the profile
+// is created for the creator on its first nft.
+func (keeper Keeper) LinkProfile(ctx sdk.Context, nft types.BaseTweetNFT) {
+	profile, found := keeper.GetProfile(ctx, nft.Platform, nft.TwitterHandle)
+	if !found {
+		profile = types.NewProfile(nft.Platform, nft.TwitterHandle, nft.Creator)
+		if addr, err := sdk.AccAddressFromBech32(nft.Creator); err == nil {
+			profile.Verified = keeper.CheckHandleAttestation(ctx, nft.Platform, nft.TwitterHandle, addr) == nil
+		}
+	}
+	
+	profile.NFTCount++
+	keeper.SetProfile(ctx, profile)
+}
+
+// CreatorPayoutAddress is the address the creator of the nft is paid at, the payout address of the
+// profile of its handle while the creator owns that profile.
+func (keeper Keeper) CreatorPayoutAddress(ctx sdk.Context, nft types.BaseTweetNFT) (sdk.AccAddress, error) {
+	if profile, found := keeper.GetProfile(ctx, nft.Platform, nft.TwitterHandle); found && profile.Owner == nft.Creator {
+		return sdk.AccAddressFromBech32(profile.Payout())
+	}
+	return sdk.AccAddressFromBech32(nft.Creator)
+}
+
+// UnlinkProfile no longer counts a burned nft towards the profile of its handle.
+func (keeper Keeper) UnlinkProfile(ctx sdk.Context, nft types.BaseTweetNFT) {
+	profile, found := keeper.GetProfile(ctx, nft.Platform, nft.TwitterHandle)
+	if !found || profile.NFTCount == 0 {
+		return
+	}
+	
+	profile.NFTCount--
+	keeper.SetProfile(ctx, profile)
+}
+
+// SyncProfileVerification follows the attestation of the handle, an attested address becomes the
+// owner of the profile and the profile is no longer verified once the attestation is gone.
+func (keeper Keeper) SyncProfileVerification(ctx sdk.Context, platform, handle string) {
+	profile, found := keeper.GetProfile(ctx, platform, handle)
+	if !found {
+		return
+	}
+	
+	profile.Verified = false
+	if attestation, found := keeper.GetHandleAttestation(ctx, platform, handle); found {
+		if addr, err := sdk.AccAddressFromBech32(attestation.Address); err == nil &&
+			keeper.CheckHandleAttestation(ctx, platform, handle, addr) == nil {
+			profile.Owner = attestation.Address
+			profile.Verified = true
+		}
+	}
+	keeper.SetProfile(ctx, profile)
+}
+
+// GetNFTsOfProfile returns the nfts minted under the handle of the profile, editions included.
+func (keeper Keeper) GetNFTsOfProfile(ctx sdk.Context, platform, handle string) []types.BaseTweetNFT {
+	nfts := make([]types.BaseTweetNFT, 0)
+	for _, nft := range keeper.GetAllTweetNFTs(ctx) {
+		if nft.Platform == platform && nft.TwitterHandle == handle {
+			nfts = append(nfts, nft)
+		}
+	}
+	return nfts
+}
+
+// MigrateProfiles links the nfts minted before profiles were introduced to the profiles of their
+// handles, nfts received from the partner chain have no creator on this chain and are skipped.
+func (keeper Keeper) MigrateProfiles(ctx sdk.Context) {
+	for _, nft := range keeper.GetAllTweetNFTs(ctx) {
+		if nft.Creator != "" && nft.TwitterHandle != "" {
+			keeper.LinkProfile(ctx, nft)
+		}
+	}
+}
+
+// RefreshVerification reports the profile unverified once the attestation behind it expired or
+// its verifier was removed.
+func (keeper Keeper) RefreshVerification(ctx sdk.Context, profile types.Profile) types.Profile {
+	if !profile.Verified {
+		return profile
+	}
+	
+	addr, err := sdk.AccAddressFromBech32(profile.Owner)
+	profile.Verified = err == nil && keeper.CheckHandleAttestation(ctx, profile.Platform, profile.Handle, addr) == nil
+	return profile
+}
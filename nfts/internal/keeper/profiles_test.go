@@ -0,0 +1,45 @@
+package keeper_test
+
+import (
+	"testing"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	
+	"github.com/FreeFlixMedia/modules/internal/testutil"
+	"github.com/FreeFlixMedia/modules/nfts/internal/types"
+)
+
+func TestCreatorIsPaidAtProfilePayout(t *testing.T) {
+	ctx, k, bank := setupKeeper(t, types.FreeFlixContext)
+	
+	creator, payout, seller, buyer := testutil.NewAddr(), testutil.NewAddr(), testutil.NewAddr(), testutil.NewAddr()
+	sold := mintNFT(t, ctx, k, creator, seller, sdk.NewDecWithPrec(5, 2))
+	owned := mintNFT(t, ctx, k, creator, creator, sdk.ZeroDec())
+	if _, err := k.UpdateProfile(ctx, types.NewMsgSetProfile(creator, types.PlatformTwitter, "creator", "", "", payout.String())); err != nil {
+		t.Fatal(err)
+	}
+	
+	bank.SetBalance(buyer, 400)
+	if _, err := k.PayForTweetNFT(ctx, sold, buyer, seller, testutil.Coin(400)); err != nil {
+		t.Fatal(err)
+	}
+	testutil.RequireBalance(t, bank, payout, 20)
+	testutil.RequireBalance(t, bank, creator, 0)
+	
+	payouts, err := k.Payouts(ctx, owned, testutil.Coin(100))
+	if err != nil {
+		t.Fatal(err)
+	} else if len(payouts) != 1 || !payouts[0].Address.Equals(payout) {
+		t.Fatalf("the fees owed to the creator should go to its payout address, got %v", payouts)
+	}
+	
+	// a profile taken over by another address does not redirect the payouts of the creator
+	profile, _ := k.GetProfile(ctx, types.PlatformTwitter, "creator")
+	profile.Owner = testutil.NewAddr().String()
+	k.SetProfile(ctx, profile)
+	if addr, err := k.CreatorPayoutAddress(ctx, owned); err != nil {
+		t.Fatal(err)
+	} else if !addr.Equals(creator) {
+		t.Fatalf("the creator should be paid directly, got %s", addr)
+	}
+}
@@ -0,0 +1,258 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	
+	"github.com/FreeFlixMedia/modules/nfts/internal/types"
+)
+
+func (keeper Keeper) GetProposalCount(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(keeper.storeKey)
+	
+	bz := store.Get(types.ProposalCountKey)
+	if bz == nil {
+		return 0
+	}
+	
+	var count uint64
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &count)
+	return count
+}
+
+func (keeper Keeper) SetProposalCount(ctx sdk.Context, count uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.ProposalCountKey, keeper.cdc.MustMarshalBinaryLengthPrefixed(count))
+}
+
+func (keeper Keeper) SetProposal(ctx sdk.Context, proposal types.Proposal) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.GetProposalKey(proposal.ID), keeper.cdc.MustMarshalBinaryLengthPrefixed(proposal))
+}
+
+func (keeper Keeper) GetProposal(ctx sdk.Context, id uint64) (types.Proposal, bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	
+	bz := store.Get(types.GetProposalKey(id))
+	if bz == nil {
+		return types.Proposal{}, false
+	}
+	
+	var proposal types.Proposal
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &proposal)
+	return proposal, true
+}
+
+func (keeper Keeper) DeleteProposal(ctx sdk.Context, proposal types.Proposal) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.GetProposalKey(proposal.ID))
+	store.Delete(types.GetProposalQueueKey(proposal.Expiry, proposal.ID))
+}
+
+func (keeper Keeper) GetAllProposals(ctx sdk.Context) []types.Proposal {
+	store := ctx.KVStore(keeper.storeKey)
+	
+	iterator := sdk.KVStorePrefixIterator(store, types.ProposalPrefix)
+	defer iterator.Close()
+	
+	proposals := make([]types.Proposal, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var proposal types.Proposal
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &proposal)
+		proposals = append(proposals, proposal)
+	}
+	
+	return proposals
+}
+
+func (keeper Keeper) GetProposalsOfNFT(ctx sdk.Context, primaryNFTID string) []types.Proposal {
+	proposals := make([]types.Proposal, 0)
+	for _, proposal := range keeper.GetAllProposals(ctx) {
+		if proposal.PrimaryNFTID == primaryNFTID {
+			proposals = append(proposals, proposal)
+		}
+	}
+	
+	return proposals
+}
+
+func (keeper Keeper) InsertProposalQueue(ctx sdk.Context, proposal types.Proposal) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.GetProposalQueueKey(proposal.Expiry, proposal.ID), types.GetProposalKey(proposal.ID))
+}
+
+// DequeueExpiredProposals removes and returns every proposal that expired at or before the current block time.
+func (keeper Keeper) DequeueExpiredProposals(ctx sdk.Context) []types.Proposal {
+	store := ctx.KVStore(keeper.storeKey)
+	
+	iterator := store.Iterator(types.ProposalQueuePrefix,
+		sdk.PrefixEndBytes(types.GetProposalQueueTimeKey(ctx.BlockTime())))
+	
+	var keys [][]byte
+	var proposals []types.Proposal
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+		
+		bz := store.Get(iterator.Value())
+		if bz == nil {
+			continue
+		}
+		
+		var proposal types.Proposal
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &proposal)
+		proposals = append(proposals, proposal)
+	}
+	iterator.Close()
+	
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	
+	return proposals
+}
+
+func (keeper Keeper) SetLicenseApproval(ctx sdk.Context, approval types.LicenseApproval) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.GetLicenseApprovalKey(approval.PrimaryNFTID, approval.Channel, approval.Recipient),
+		keeper.cdc.MustMarshalBinaryLengthPrefixed(approval))
+}
+
+// ConsumeLicenseApproval reports whether the co-owners approved the license and removes the approval.
+func (keeper Keeper) ConsumeLicenseApproval(ctx sdk.Context, primaryNFTID, channel, recipient string) bool {
+	store := ctx.KVStore(keeper.storeKey)
+	
+	key := types.GetLicenseApprovalKey(primaryNFTID, channel, recipient)
+	if !store.Has(key) {
+		return false
+	}
+	
+	store.Delete(key)
+	return true
+}
+
+func (keeper Keeper) GetAllLicenseApprovals(ctx sdk.Context) []types.LicenseApproval {
+	store := ctx.KVStore(keeper.storeKey)
+	
+	iterator := sdk.KVStorePrefixIterator(store, types.LicenseApprovalPrefix)
+	defer iterator.Close()
+	
+	var approvals []types.LicenseApproval
+	for ; iterator.Valid(); iterator.Next() {
+		var approval types.LicenseApproval
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &approval)
+		approvals = append(approvals, approval)
+	}
+	
+	return approvals
+}
+
+// SetCoOwners hands the nft over to its co-owners, from then on it is held by the co-owned
+// address and only approved proposals act on it.
+func (keeper Keeper) SetCoOwners(ctx sdk.Context, msg types.MsgSetCoOwners) (types.BaseTweetNFT, error) {
+	if types.GetContextOfCurrentChain() != types.FreeFlixContext {
+		return types.BaseTweetNFT{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "only primary nfts can be co-owned")
+	}
+	
+	nft, found := keeper.GetTweetNFTByID(ctx, msg.PrimaryNFTID)
+	if !found {
+		return types.BaseTweetNFT{}, sdkerrors.Wrap(types.ErrNFTNotFound, msg.PrimaryNFTID)
+	}
+	
+	if nft.PrimaryOwner != msg.Sender.String() {
+		return types.BaseTweetNFT{}, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only primary owner can set co-owners")
+	} else if keeper.IsEscrowed(ctx, nft.PrimaryNFTID) {
+		return types.BaseTweetNFT{}, sdkerrors.Wrap(types.ErrNFTEscrowed, nft.PrimaryNFTID)
+	}
+	
+	nft.CoOwners = nil
+	for _, coOwner := range msg.CoOwners {
+		nft.CoOwners = append(nft.CoOwners, coOwner.String())
+	}
+	nft.Threshold = msg.Threshold
+	
+	keeper.TransferTweetNFT(ctx, nft, msg.Sender, types.CoOwnedAddress(nft.PrimaryNFTID))
+	return nft, nil
+}
+
+// SubmitProposal records the proposal with the approval of its proposer, it executes right away
+// when that already meets the threshold.
+func (keeper Keeper) SubmitProposal(ctx sdk.Context, msg types.MsgSubmitProposal) (types.Proposal, bool, error) {
+	nft, found := keeper.GetTweetNFTByID(ctx, msg.PrimaryNFTID)
+	if !found {
+		return types.Proposal{}, false, sdkerrors.Wrap(types.ErrNFTNotFound, msg.PrimaryNFTID)
+	}
+	
+	if !nft.IsCoOwned() || !nft.IsCoOwner(msg.Sender.String()) {
+		return types.Proposal{}, false, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only co-owners can submit proposals")
+	}
+	
+	count := keeper.GetProposalCount(ctx)
+	proposal := types.NewProposal(count, msg, ctx.BlockTime().Add(keeper.GetParams(ctx).ProposalWindow))
+	keeper.SetProposalCount(ctx, count+1)
+	
+	return keeper.tallyProposal(ctx, nft, proposal)
+}
+
+func (keeper Keeper) ApproveProposal(ctx sdk.Context, sender sdk.AccAddress, id uint64) (types.Proposal, bool, error) {
+	proposal, found := keeper.GetProposal(ctx, id)
+	if !found {
+		return types.Proposal{}, false, sdkerrors.Wrapf(types.ErrProposalNotFound, "%d", id)
+	}
+	
+	nft, found := keeper.GetTweetNFTByID(ctx, proposal.PrimaryNFTID)
+	if !found {
+		return types.Proposal{}, false, sdkerrors.Wrap(types.ErrNFTNotFound, proposal.PrimaryNFTID)
+	}
+	
+	if !nft.IsCoOwner(sender.String()) {
+		return types.Proposal{}, false, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only co-owners can approve proposals")
+	} else if proposal.HasApproved(sender.String()) {
+		return types.Proposal{}, false, sdkerrors.Wrapf(types.ErrInvalidProposal, "%s already approved proposal %d", sender, id)
+	}
+	
+	proposal.Approvals = append(proposal.Approvals, sender.String())
+	return keeper.tallyProposal(ctx, nft, proposal)
+}
+
+func (keeper Keeper) tallyProposal(ctx sdk.Context, nft types.BaseTweetNFT, proposal types.Proposal) (types.Proposal, bool, error) {
+	if uint64(len(proposal.Approvals)) < nft.Threshold {
+		keeper.SetProposal(ctx, proposal)
+		keeper.InsertProposalQueue(ctx, proposal)
+		return proposal, false, nil
+	}
+	
+	keeper.DeleteProposal(ctx, proposal)
+	return proposal, true, keeper.executeProposal(ctx, nft, proposal)
+}
+
+func (keeper Keeper) executeProposal(ctx sdk.Context, nft types.BaseTweetNFT, proposal types.Proposal) error {
+	owner := types.CoOwnedAddress(nft.PrimaryNFTID)
+	
+	switch proposal.Action {
+	case types.ProposalActionLicense:
+		if !nft.IsLicensable() {
+			return sdkerrors.Wrapf(types.ErrInvalidLicense, "%s is not licensable", nft.PrimaryNFTID)
+		}
+		keeper.SetLicenseApproval(ctx, types.NewLicenseApproval(nft.PrimaryNFTID, proposal.Channel, proposal.Recipient))
+	case types.ProposalActionTransfer:
+		recipient, err := sdk.AccAddressFromBech32(proposal.Recipient)
+		if err != nil {
+			return err
+		}
+		
+		nft.CoOwners = nil
+		nft.Threshold = 0
+		keeper.TransferTweetNFT(ctx, nft, owner, recipient)
+	case types.ProposalActionUpdateTerms:
+		nft.LicenseTerms = proposal.LicenseTerms
+		nft.LicensingFee = proposal.LicensingFee
+		nft.RevenueShare = proposal.RevenueShare
+		nft.MaxLicensees = proposal.MaxLicensees
+		keeper.MintTweetNFT(ctx, nft)
+	case types.ProposalActionBurn:
+		return keeper.BurnTweetNFT(ctx, nft, owner)
+	default:
+		return sdkerrors.Wrapf(types.ErrInvalidProposal, "unknown action %s", proposal.Action)
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package keeper_test
+
+import (
+	"testing"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	
+	"github.com/FreeFlixMedia/modules/internal/testutil"
+	"github.com/FreeFlixMedia/modules/nfts/internal/types"
+)
+
+func TestBurnProposalRefusedWhileLicensed(t *testing.T) {
+	ctx, k, _ := setupKeeper(t, types.FreeFlixContext)
+	
+	owner, coOwner := testutil.NewAddr(), testutil.NewAddr()
+	nft := mintNFT(t, ctx, k, owner, owner, sdk.ZeroDec())
+	msg := types.MsgSetCoOwners{Sender: owner, PrimaryNFTID: nft.PrimaryNFTID, CoOwners: []sdk.AccAddress{owner, coOwner}, Threshold: 1}
+	if _, err := k.SetCoOwners(ctx, msg); err != nil {
+		t.Fatal(err)
+	}
+	
+	grant := types.NewLicenseGrant(nft.PrimaryNFTID, "secondary", testutil.NewAddr().String(), "channel-0", ctx.BlockHeight())
+	k.SetLicenseGrant(ctx, grant)
+	
+	burn := types.MsgSubmitProposal{Sender: owner, PrimaryNFTID: nft.PrimaryNFTID, Action: types.ProposalActionBurn}
+	if _, _, err := k.SubmitProposal(ctx, burn); err == nil {
+		t.Fatal("an nft with an active license should not be burned")
+	} else if _, found := k.GetTweetNFTByID(ctx, nft.PrimaryNFTID); !found {
+		t.Fatal("the refused burn should leave the nft")
+	}
+	
+	grant.Status = types.LicenseStatusExpired
+	k.SetLicenseGrant(ctx, grant)
+	if _, _, err := k.SubmitProposal(ctx, burn); err != nil {
+		t.Fatal(err)
+	}
+	if _, found := k.GetTweetNFTByID(ctx, nft.PrimaryNFTID); found {
+		t.Fatal("the nft should be burned")
+	} else if len(k.GetLicenseGrants(ctx, nft.PrimaryNFTID)) != 0 {
+		t.Fatal("the expired grant should be deleted with the nft")
+	}
+}
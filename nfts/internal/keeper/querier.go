@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 	
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -18,6 +20,52 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryUsingNFTID(ctx, path[1:], k)
 		case types.QueryTweetNFTsByAddress:
 			return queryTweetNFTsByAddress(ctx, path[1:], k)
+		case types.QueryLicenseGrants:
+			return queryLicenseGrants(ctx, path[1:], k)
+		case types.QueryListingsBySeller:
+			return queryListingsBySeller(ctx, path[1:], k)
+		case types.QueryListingsByPrice:
+			return queryListingsByPrice(ctx, path[1:], k)
+		case types.QueryListingsByHandle:
+			return queryListingsByHandle(ctx, path[1:], k)
+		case types.QueryParams:
+			return queryParams(ctx, k)
+		case types.QueryAuction:
+			return queryAuction(ctx, path[1:], k)
+		case types.QueryAuctions:
+			return queryAuctions(ctx, k)
+		case types.QueryProposals:
+			return queryProposals(ctx, path[1:], k)
+		case types.QueryNFTApproval:
+			return queryNFTApproval(ctx, path[1:], k)
+		case types.QueryOperators:
+			return queryOperators(ctx, path[1:], k)
+		case types.QueryBundle:
+			return queryBundle(ctx, path[1:], k)
+		case types.QueryBundles:
+			return queryBundles(ctx, k)
+		case types.QueryTweetNFTsByTag:
+			return queryTweetNFTsByTag(ctx, path[1:], k)
+		case types.QueryCollection:
+			return queryCollection(ctx, path[1:], k)
+		case types.QueryCollections:
+			return queryCollections(ctx, k)
+		case types.QueryCollectionNFTs:
+			return queryCollectionNFTs(ctx, path[1:], k)
+		case types.QueryEditions:
+			return queryEditions(ctx, path[1:], k)
+		case types.QueryNFTByContentHash:
+			return queryNFTByContentHash(ctx, path[1:], k)
+		case types.QueryAttestation:
+			return queryHandleAttestation(ctx, path[1:], k)
+		case types.QueryAttestations:
+			return queryHandleAttestations(ctx, k)
+		case types.QueryProfile:
+			return queryProfile(ctx, path[1:], k)
+		case types.QueryProfiles:
+			return queryProfiles(ctx, k)
+		case types.QueryProfileNFTs:
+			return queryProfileNFTs(ctx, path[1:], k)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query path: %s", path[0])
 		}
@@ -54,3 +102,327 @@ func queryTweetNFTsByAddress(ctx sdk.Context, path []string, k Keeper) ([]byte,
 	
 	return res, nil
 }
+
+func queryLicenseGrants(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
+	if _, found := k.GetTweetNFTByID(ctx, path[0]); !found {
+		return nil, sdkerrors.Wrap(types.ErrNFTNotFound, fmt.Sprintf("nft %s ", path[0]))
+	}
+	
+	grants := k.GetLicenseGrants(ctx, path[0])
+	
+	res, err := codec.MarshalJSONIndent(k.cdc, grants)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	
+	return res, nil
+}
+
+func queryListingsBySeller(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
+	if _, err := sdk.AccAddressFromBech32(path[0]); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, path[0])
+	}
+	
+	listings := make([]types.Listing, 0)
+	for _, listing := range k.GetAllListings(ctx) {
+		if listing.Seller == path[0] {
+			listings = append(listings, listing)
+		}
+	}
+	
+	return marshalListings(k, listings)
+}
+
+func queryListingsByPrice(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
+	minPrice, err := sdk.ParseCoin(path[0])
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, path[0])
+	}
+	maxPrice, err := sdk.ParseCoin(path[1])
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, path[1])
+	}
+	
+	listings := make([]types.Listing, 0)
+	for _, listing := range k.GetAllListings(ctx) {
+		if listing.Price.Denom != minPrice.Denom || listing.Price.Denom != maxPrice.Denom {
+			continue
+		}
+		if !listing.Price.IsLT(minPrice) && !maxPrice.IsLT(listing.Price) {
+			listings = append(listings, listing)
+		}
+	}
+	
+	return marshalListings(k, listings)
+}
+
+func queryListingsByHandle(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
+	listings := make([]types.Listing, 0)
+	for _, listing := range k.GetAllListings(ctx) {
+		nft, found := k.GetTweetNFTByID(ctx, listing.PrimaryNFTID)
+		if found && strings.EqualFold(nft.TwitterHandle, path[0]) {
+			listings = append(listings, listing)
+		}
+	}
+	
+	return marshalListings(k, listings)
+}
+
+func marshalListings(k Keeper, listings []types.Listing) ([]byte, error) {
+	res, err := codec.MarshalJSONIndent(k.cdc, listings)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	
+	return res, nil
+}
+
+func queryParams(ctx sdk.Context, k Keeper) ([]byte, error) {
+	res, err := codec.MarshalJSONIndent(k.cdc, k.GetParams(ctx))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	
+	return res, nil
+}
+
+func queryAuction(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
+	id, err := strconv.ParseUint(path[0], 10, 64)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	
+	auction, found := k.GetAuction(ctx, id)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrAuctionNotFound, "%d", id)
+	}
+	
+	res, err := codec.MarshalJSONIndent(k.cdc, auction)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	
+	return res, nil
+}
+
+func queryAuctions(ctx sdk.Context, k Keeper) ([]byte, error) {
+	res, err := codec.MarshalJSONIndent(k.cdc, k.GetAllAuctions(ctx))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	
+	return res, nil
+}
+
+func queryProposals(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
+	res, err := codec.MarshalJSONIndent(k.cdc, k.GetProposalsOfNFT(ctx, path[0]))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	
+	return res, nil
+}
+
+func queryNFTApproval(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
+	approval, found := k.GetNFTApproval(ctx, path[0])
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrNFTApprovalNotFound, path[0])
+	}
+	
+	res, err := codec.MarshalJSONIndent(k.cdc, approval)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	
+	return res, nil
+}
+
+func queryOperators(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
+	res, err := codec.MarshalJSONIndent(k.cdc, k.GetOperators(ctx, path[0]))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	
+	return res, nil
+}
+
+func queryBundle(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
+	id, err := strconv.ParseUint(path[0], 10, 64)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	
+	bundle, found := k.GetBundle(ctx, id)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrBundleNotFound, "%d", id)
+	}
+	
+	res, err := codec.MarshalJSONIndent(k.cdc, bundle)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	
+	return res, nil
+}
+
+func queryBundles(ctx sdk.Context, k Keeper) ([]byte, error) {
+	res, err := codec.MarshalJSONIndent(k.cdc, k.GetAllBundles(ctx))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	
+	return res, nil
+}
+
+func queryTweetNFTsByTag(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
+	tweets := make([]types.BaseTweetNFT, 0)
+	for _, nft := range k.GetAllTweetNFTs(ctx) {
+		if nft.Metadata.HasTag(path[0]) {
+			tweets = append(tweets, nft)
+		}
+	}
+	
+	res, err := codec.MarshalJSONIndent(k.cdc, tweets)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	
+	return res, nil
+}
+
+func queryCollection(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
+	collection, found := k.GetCollection(ctx, path[0])
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrCollectionNotFound, path[0])
+	}
+	
+	res, err := codec.MarshalJSONIndent(k.cdc, collection)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	
+	return res, nil
+}
+
+func queryCollections(ctx sdk.Context, k Keeper) ([]byte, error) {
+	res, err := codec.MarshalJSONIndent(k.cdc, k.GetAllCollections(ctx))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	
+	return res, nil
+}
+
+func queryCollectionNFTs(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
+	res, err := codec.MarshalJSONIndent(k.cdc, k.GetNFTsOfCollection(ctx, path[0]))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	
+	return res, nil
+}
+
+func queryEditions(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
+	res, err := codec.MarshalJSONIndent(k.cdc, k.GetEditionsOfMaster(ctx, path[0]))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	
+	return res, nil
+}
+
+func queryNFTByContentHash(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
+	hash, err := types.ParseContentHash(types.DetectHashAlgorithm(path[0]), path[0])
+	if err != nil {
+		return nil, err
+	}
+	
+	nft, found := k.GetNFTByContentHash(ctx, hash)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrNFTNotFound, "no nft minted for %s", path[0])
+	}
+	
+	res, err := codec.MarshalJSONIndent(k.cdc, nft)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	
+	return res, nil
+}
+
+func queryHandleAttestation(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
+	if len(path) < 2 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "platform and handle are required")
+	}
+	
+	platform := types.NormalizePlatform(path[0])
+	handle := types.NormalizeHandle(platform, path[1])
+	attestation, found := k.GetHandleAttestation(ctx, platform, handle)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrAttestationNotFound, "%s handle %s", platform, handle)
+	}
+	
+	res, err := codec.MarshalJSONIndent(k.cdc, attestation)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	
+	return res, nil
+}
+
+func queryHandleAttestations(ctx sdk.Context, k Keeper) ([]byte, error) {
+	res, err := codec.MarshalJSONIndent(k.cdc, k.GetAllHandleAttestations(ctx))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	
+	return res, nil
+}
+
+func queryProfile(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
+	if len(path) < 2 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "platform and handle are required")
+	}
+	
+	platform := types.NormalizePlatform(path[0])
+	handle := types.NormalizeHandle(platform, path[1])
+	profile, found := k.GetProfile(ctx, platform, handle)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrProfileNotFound, "%s handle %s", platform, handle)
+	}
+	
+	res, err := codec.MarshalJSONIndent(k.cdc, k.RefreshVerification(ctx, profile))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	
+	return res, nil
+}
+
+func queryProfiles(ctx sdk.Context, k Keeper) ([]byte, error) {
+	profiles := k.GetAllProfiles(ctx)
+	for i, profile := range profiles {
+		profiles[i] = k.RefreshVerification(ctx, profile)
+	}
+	
+	res, err := codec.MarshalJSONIndent(k.cdc, profiles)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	
+	return res, nil
+}
+
+func queryProfileNFTs(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
+	if len(path) < 2 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "platform and handle are required")
+	}
+	
+	platform := types.NormalizePlatform(path[0])
+	res, err := codec.MarshalJSONIndent(k.cdc, k.GetNFTsOfProfile(ctx, platform, types.NormalizeHandle(platform, path[1])))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	
+	return res, nil
+}
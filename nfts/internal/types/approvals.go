@@ -0,0 +1,40 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NFTApproval lets the approved address manage a single nft on behalf of its primary owner, it is
+// cleared whenever the nft changes hands.
+type NFTApproval struct {
+	PrimaryNFTID string `json:"primary_nft_id"`
+	Approved     string `json:"approved"`
+}
+
+func NewNFTApproval(primaryNFTID, approved string) NFTApproval {
+	return NFTApproval{
+		PrimaryNFTID: primaryNFTID,
+		Approved:     approved,
+	}
+}
+
+func (a NFTApproval) String() string {
+	return fmt.Sprintf("%s:%s", a.PrimaryNFTID, a.Approved)
+}
+
+// OperatorApproval lets the operator manage every nft of the owner.
+type OperatorApproval struct {
+	Owner    string `json:"owner"`
+	Operator string `json:"operator"`
+}
+
+func NewOperatorApproval(owner, operator string) OperatorApproval {
+	return OperatorApproval{
+		Owner:    owner,
+		Operator: operator,
+	}
+}
+
+func (a OperatorApproval) String() string {
+	return fmt.Sprintf("%s:%s", a.Owner, a.Operator)
+}
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"fmt"
+	"time"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// HandleAttestation binds a handle on a platform to the address of its owner, it is submitted
+// by a verifier and holds until its expiry, if any, or until it is revoked.
+type HandleAttestation struct {
+	Platform   string    `json:"platform"`
+	Handle     string    `json:"handle"`
+	Address    string    `json:"address"`
+	Verifier   string    `json:"verifier"`
+	ExpiryTime time.Time `json:"expiry_time"`
+}
+
+func NewHandleAttestation(platform, handle, address, verifier string, expiry time.Time) HandleAttestation {
+	return HandleAttestation{
+		Platform:   platform,
+		Handle:     handle,
+		Address:    address,
+		Verifier:   verifier,
+		ExpiryTime: expiry,
+	}
+}
+
+func (a HandleAttestation) IsExpired(now time.Time) bool {
+	return !a.ExpiryTime.IsZero() && !now.Before(a.ExpiryTime)
+}
+
+func (a HandleAttestation) ValidateBasic() error {
+	if !IsValidPlatform(a.Platform) {
+		return sdkerrors.Wrapf(ErrInvalidAttestation, "unknown platform %s", a.Platform)
+	} else if err := ValidateHandle(a.Platform, a.Handle); err != nil {
+		return sdkerrors.Wrap(ErrInvalidAttestation, err.Error())
+	} else if _, err := sdk.AccAddressFromBech32(a.Address); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidAttestation, "invalid address %s", a.Address)
+	} else if _, err := sdk.AccAddressFromBech32(a.Verifier); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidAttestation, "invalid verifier %s", a.Verifier)
+	}
+	return nil
+}
+
+func (a HandleAttestation) String() string {
+	return fmt.Sprintf(`
+Platform: %s,
+Handle: %s,
+Address: %s,
+Verifier: %s,
+ExpiryTime: %s
+`, a.Platform, a.Handle, a.Address, a.Verifier, a.ExpiryTime)
+}
@@ -0,0 +1,127 @@
+package types
+
+import (
+	"fmt"
+	"time"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	AuctionKindOwnership = "ownership"
+	AuctionKindLicense   = "license"
+	
+	AuctionTypeEnglish = "english"
+	AuctionTypeDutch   = "dutch"
+	
+	AuctionStatusActive   = "active"
+	AuctionStatusSettling = "settling"
+)
+
+// Auction sells either the primary nft itself or an exclusive license of it. An english auction
+// is won by the highest bid placed before EndTime, a dutch auction by the first bid that meets
+// the price falling linearly from StartPrice to FloorPrice. A license auction is granted to
+// Licensee on the licensee chain over Channel, the winning bid stays escrowed while the grant
+// is settling.
+type Auction struct {
+	ID            uint64    `json:"id"`
+	PrimaryNFTID  string    `json:"primary_nft_id"`
+	Seller        string    `json:"seller"`
+	Kind          string    `json:"kind"`
+	AuctionType   string    `json:"auction_type"`
+	StartPrice    sdk.Coin  `json:"start_price"`
+	FloorPrice    sdk.Coin  `json:"floor_price"`
+	StartTime     time.Time `json:"start_time"`
+	EndTime       time.Time `json:"end_time"`
+	Channel       string    `json:"channel"`
+	HighestBid    sdk.Coin  `json:"highest_bid"`
+	HighestBidder string    `json:"highest_bidder"`
+	Licensee      string    `json:"licensee"`
+	Status        string    `json:"status"`
+}
+
+func NewAuction(id uint64, primaryNFTID, seller, kind, auctionType string, startPrice, floorPrice sdk.Coin,
+	startTime, endTime time.Time, channel string) Auction {
+	return Auction{
+		ID:           id,
+		PrimaryNFTID: primaryNFTID,
+		Seller:       seller,
+		Kind:         kind,
+		AuctionType:  auctionType,
+		StartPrice:   startPrice,
+		FloorPrice:   floorPrice,
+		StartTime:    startTime,
+		EndTime:      endTime,
+		Channel:      channel,
+		Status:       AuctionStatusActive,
+	}
+}
+
+func (a Auction) IsActive() bool {
+	return a.Status == AuctionStatusActive
+}
+
+func (a Auction) HasBid() bool {
+	return a.HighestBidder != ""
+}
+
+// CurrentPrice is the lowest acceptable bid at the given time.
+func (a Auction) CurrentPrice(now time.Time) sdk.Coin {
+	if a.AuctionType != AuctionTypeDutch || !now.After(a.StartTime) {
+		return a.StartPrice
+	} else if !now.Before(a.EndTime) {
+		return a.FloorPrice
+	}
+	
+	elapsed := sdk.NewInt(int64(now.Sub(a.StartTime)))
+	duration := sdk.NewInt(int64(a.EndTime.Sub(a.StartTime)))
+	drop := a.StartPrice.Amount.Sub(a.FloorPrice.Amount).Mul(elapsed).Quo(duration)
+	return sdk.NewCoin(a.StartPrice.Denom, a.StartPrice.Amount.Sub(drop))
+}
+
+// ValidateBid returns the amount to escrow for the bid.
+func (a Auction) ValidateBid(amount sdk.Coin, now time.Time) (sdk.Coin, error) {
+	if !a.IsActive() || !now.Before(a.EndTime) {
+		return sdk.Coin{}, sdkerrors.Wrapf(ErrAuctionClosed, "auction %d ended at %s", a.ID, a.EndTime)
+	} else if amount.Denom != a.StartPrice.Denom {
+		return sdk.Coin{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "bids should be in %s", a.StartPrice.Denom)
+	}
+	
+	price := a.CurrentPrice(now)
+	if amount.IsLT(price) {
+		return sdk.Coin{}, sdkerrors.Wrapf(ErrInvalidBid, "bid should be at least %s", price)
+	}
+	
+	if a.AuctionType == AuctionTypeDutch {
+		if a.HasBid() {
+			return sdk.Coin{}, sdkerrors.Wrapf(ErrAuctionClosed, "auction %d is already won", a.ID)
+		}
+		return price, nil
+	}
+	
+	if a.HasBid() && !a.HighestBid.IsLT(amount) {
+		return sdk.Coin{}, sdkerrors.Wrapf(ErrInvalidBid, "bid should be higher than %s", a.HighestBid)
+	}
+	return amount, nil
+}
+
+func (a Auction) String() string {
+	return fmt.Sprintf(`
+ID: %d,
+PrimaryNFTID: %s,
+Seller: %s,
+Kind: %s,
+AuctionType: %s,
+StartPrice: %s,
+FloorPrice: %s,
+StartTime: %s,
+EndTime: %s,
+Channel: %s,
+HighestBid: %s,
+HighestBidder: %s,
+Licensee: %s,
+Status: %s
+`, a.ID, a.PrimaryNFTID, a.Seller, a.Kind, a.AuctionType, a.StartPrice, a.FloorPrice, a.StartTime, a.EndTime,
+		a.Channel, a.HighestBid, a.HighestBidder, a.Licensee, a.Status)
+}
@@ -0,0 +1,126 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// BundleMember is the weight of a primary nft in the licensing fee of a bundle.
+type BundleMember struct {
+	PrimaryNFTID string  `json:"primary_nft_id"`
+	Weight       sdk.Dec `json:"weight"`
+}
+
+func NewBundleMember(primaryNFTID string, weight sdk.Dec) BundleMember {
+	return BundleMember{
+		PrimaryNFTID: primaryNFTID,
+		Weight:       weight,
+	}
+}
+
+func (member BundleMember) String() string {
+	return fmt.Sprintf("%s:%s", member.PrimaryNFTID, member.Weight)
+}
+
+// ParseBundleMembers parses members in the primary-nft-id:weight,primary-nft-id:weight format.
+func ParseBundleMembers(str string) ([]BundleMember, error) {
+	var members []BundleMember
+	for _, entry := range strings.Split(strings.TrimSpace(str), ",") {
+		parts := strings.Split(strings.TrimSpace(entry), ":")
+		if len(parts) != 2 {
+			return nil, sdkerrors.Wrapf(ErrInvalidBundle, "invalid member %s", entry)
+		}
+		
+		weight, err := sdk.NewDecFromStr(parts[1])
+		if err != nil {
+			return nil, sdkerrors.Wrapf(ErrInvalidBundle, "invalid weight %s", parts[1])
+		}
+		members = append(members, NewBundleMember(parts[0], weight))
+	}
+	return members, nil
+}
+
+// ValidateBundleMembers checks the members are distinct nfts with positive weights summing to 1.
+func ValidateBundleMembers(members []BundleMember) error {
+	if len(members) == 0 {
+		return sdkerrors.Wrap(ErrInvalidBundle, "bundle should have members")
+	}
+	
+	total := sdk.ZeroDec()
+	seen := make(map[string]bool)
+	for _, member := range members {
+		if member.PrimaryNFTID == "" {
+			return sdkerrors.Wrap(ErrInvalidBundle, "primary nft id should not be empty")
+		} else if seen[member.PrimaryNFTID] {
+			return sdkerrors.Wrapf(ErrInvalidBundle, "duplicate member %s", member.PrimaryNFTID)
+		} else if member.Weight.IsNil() || !member.Weight.IsPositive() {
+			return sdkerrors.Wrapf(ErrInvalidBundle, "weight of %s should be positive", member.PrimaryNFTID)
+		}
+		
+		seen[member.PrimaryNFTID] = true
+		total = total.Add(member.Weight)
+	}
+	
+	if !total.Equal(sdk.OneDec()) {
+		return sdkerrors.Wrapf(ErrInvalidBundle, "weights sum to %s instead of 1", total)
+	}
+	return nil
+}
+
+// Bundle licenses all of its members for a single price under shared terms, the price is split
+// across the members by weight.
+type Bundle struct {
+	ID           uint64         `json:"id"`
+	Name         string         `json:"name"`
+	Owner        string         `json:"owner"`
+	Members      []BundleMember `json:"members"`
+	Price        sdk.Coin       `json:"price"`
+	LicenseTerms LicenseTerms   `json:"license_terms"`
+	RevenueShare sdk.Dec        `json:"revenue_share"`
+}
+
+func NewBundle(id uint64, msg MsgCreateBundle) Bundle {
+	return Bundle{
+		ID:           id,
+		Name:         msg.Name,
+		Owner:        msg.Sender.String(),
+		Members:      msg.Members,
+		Price:        msg.Price,
+		LicenseTerms: msg.LicenseTerms,
+		RevenueShare: msg.RevenueShare,
+	}
+}
+
+// MemberShares splits the fee across the members by weight, the rounding dust goes to the first member.
+func (b Bundle) MemberShares(fee sdk.Coin) []sdk.Coin {
+	shares := make([]sdk.Coin, len(b.Members))
+	remaining := fee.Amount
+	for i, member := range b.Members {
+		share := fee.Amount.ToDec().Mul(member.Weight).TruncateInt()
+		shares[i] = sdk.NewCoin(fee.Denom, share)
+		remaining = remaining.Sub(share)
+	}
+	
+	shares[0] = shares[0].Add(sdk.NewCoin(fee.Denom, remaining))
+	return shares
+}
+
+func (b Bundle) String() string {
+	members := make([]string, len(b.Members))
+	for i, member := range b.Members {
+		members[i] = member.String()
+	}
+	
+	return fmt.Sprintf(`
+ID: %d,
+Name: %s,
+Owner: %s,
+Members: %s,
+Price: %s,
+LicenseTerms: %s,
+RevenueShare: %s
+`, b.ID, b.Name, b.Owner, strings.Join(members, ","), b.Price, b.LicenseTerms, b.RevenueShare)
+}
@@ -7,7 +7,36 @@ import (
 
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgMintTweetNFT{}, "nft/MsgMintTweetNFT", nil)
+	cdc.RegisterConcrete(MsgBatchMintTweetNFTs{}, "nft/MsgBatchMintTweetNFTs", nil)
+	cdc.RegisterConcrete(MsgUpdateLicenseCap{}, "nft/MsgUpdateLicenseCap", nil)
+	cdc.RegisterConcrete(MsgListTweetNFT{}, "nft/MsgListTweetNFT", nil)
+	cdc.RegisterConcrete(MsgDelistTweetNFT{}, "nft/MsgDelistTweetNFT", nil)
+	cdc.RegisterConcrete(MsgBuyTweetNFT{}, "nft/MsgBuyTweetNFT", nil)
+	cdc.RegisterConcrete(MsgTransferTweetNFT{}, "nft/MsgTransferTweetNFT", nil)
+	cdc.RegisterConcrete(MsgCreateAuction{}, "nft/MsgCreateAuction", nil)
+	cdc.RegisterConcrete(MsgPlaceBid{}, "nft/MsgPlaceBid", nil)
+	cdc.RegisterConcrete(MsgCancelAuction{}, "nft/MsgCancelAuction", nil)
+	cdc.RegisterConcrete(MsgUpdateRevenueSplits{}, "nft/MsgUpdateRevenueSplits", nil)
+	cdc.RegisterConcrete(MsgSetCoOwners{}, "nft/MsgSetCoOwners", nil)
+	cdc.RegisterConcrete(MsgSubmitProposal{}, "nft/MsgSubmitProposal", nil)
+	cdc.RegisterConcrete(MsgApproveProposal{}, "nft/MsgApproveProposal", nil)
+	cdc.RegisterConcrete(MsgApproveNFT{}, "nft/MsgApproveNFT", nil)
+	cdc.RegisterConcrete(MsgRevokeNFTApproval{}, "nft/MsgRevokeNFTApproval", nil)
+	cdc.RegisterConcrete(MsgSetOperator{}, "nft/MsgSetOperator", nil)
+	cdc.RegisterConcrete(MsgCreateBundle{}, "nft/MsgCreateBundle", nil)
+	cdc.RegisterConcrete(MsgDeleteBundle{}, "nft/MsgDeleteBundle", nil)
+	cdc.RegisterConcrete(MsgUpdateNFTMetadata{}, "nft/MsgUpdateNFTMetadata", nil)
+	cdc.RegisterConcrete(MsgCreateCollection{}, "nft/MsgCreateCollection", nil)
+	cdc.RegisterConcrete(MsgMintEdition{}, "nft/MsgMintEdition", nil)
+	cdc.RegisterConcrete(MsgAttestHandle{}, "nft/MsgAttestHandle", nil)
+	cdc.RegisterConcrete(MsgRevokeHandleAttestation{}, "nft/MsgRevokeHandleAttestation", nil)
+	cdc.RegisterConcrete(MsgSetProfile{}, "nft/MsgSetProfile", nil)
 	cdc.RegisterConcrete(BaseTweetNFT{}, "nft/BaseTweetNFT", nil)
+	cdc.RegisterConcrete(LicenseGrant{}, "nft/LicenseGrant", nil)
+	cdc.RegisterConcrete(LicenseTerms{}, "nft/LicenseTerms", nil)
+	cdc.RegisterConcrete(Listing{}, "nft/Listing", nil)
+	cdc.RegisterConcrete(Auction{}, "nft/Auction", nil)
+	cdc.RegisterConcrete(Proposal{}, "nft/Proposal", nil)
 }
 
 var (
@@ -0,0 +1,135 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	MintPermissionCreator   = "creator"
+	MintPermissionAllowlist = "allowlist"
+	MintPermissionAnyone    = "anyone"
+	
+	MaxCollectionNameLength   = 64
+	MaxCollectionSchemaLength = 4096
+)
+
+var collectionIDRegex = regexp.MustCompile(`^[a-z][a-z0-9]{2,31}$`)
+
+// Collection groups nfts under their own id prefix, the nfts of a collection get ids of the
+// form <collection-id>-<count>. Nfts minted without a collection are tweet nfts.
+type Collection struct {
+	ID             string   `json:"id"`
+	Name           string   `json:"name"`
+	Description    string   `json:"description"`
+	Schema         string   `json:"schema"`
+	Creator        string   `json:"creator"`
+	MintPermission string   `json:"mint_permission"`
+	Minters        []string `json:"minters"`
+}
+
+func NewCollection(id, name, description, schema, creator, permission string, minters []string) Collection {
+	return Collection{
+		ID:             id,
+		Name:           name,
+		Description:    description,
+		Schema:         schema,
+		Creator:        creator,
+		MintPermission: permission,
+		Minters:        minters,
+	}
+}
+
+func IsValidMintPermission(permission string) bool {
+	switch permission {
+	case MintPermissionCreator, MintPermissionAllowlist, MintPermissionAnyone:
+		return true
+	default:
+		return false
+	}
+}
+
+// ValidateCollectionID checks the id can prefix nft ids without clashing with tweet nfts.
+func ValidateCollectionID(id string) error {
+	if !collectionIDRegex.MatchString(id) {
+		return sdkerrors.Wrapf(ErrInvalidCollection, "collection id %s should be 3 to 32 lower case letters and digits", id)
+	} else if strings.HasPrefix(id, FreeFlixNFTPrefix) || strings.HasPrefix(id, CoCoNFTPrefix) {
+		return sdkerrors.Wrapf(ErrInvalidCollection, "collection id %s is reserved", id)
+	}
+	return nil
+}
+
+func (c Collection) ValidateBasic() error {
+	if err := ValidateCollectionID(c.ID); err != nil {
+		return err
+	}
+	
+	if c.Name == "" || len(c.Name) > MaxCollectionNameLength {
+		return sdkerrors.Wrapf(ErrInvalidCollection, "name should have 1 to %d characters", MaxCollectionNameLength)
+	} else if len(c.Description) > MaxDescriptionLength {
+		return sdkerrors.Wrapf(ErrInvalidCollection, "description can not exceed %d characters", MaxDescriptionLength)
+	} else if len(c.Schema) > MaxCollectionSchemaLength {
+		return sdkerrors.Wrapf(ErrInvalidCollection, "schema can not exceed %d characters", MaxCollectionSchemaLength)
+	} else if c.Schema != "" && !json.Valid([]byte(c.Schema)) {
+		return sdkerrors.Wrap(ErrInvalidCollection, "schema should be a json document")
+	}
+	
+	if !IsValidMintPermission(c.MintPermission) {
+		return sdkerrors.Wrapf(ErrInvalidCollection, "unknown mint permission %s", c.MintPermission)
+	} else if c.MintPermission != MintPermissionAllowlist && len(c.Minters) != 0 {
+		return sdkerrors.Wrap(ErrInvalidCollection, "minters are only allowed with the allowlist permission")
+	}
+	
+	seen := make(map[string]bool)
+	for _, minter := range c.Minters {
+		if _, err := sdk.AccAddressFromBech32(minter); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidCollection, "invalid minter %s", minter)
+		} else if seen[minter] {
+			return sdkerrors.Wrapf(ErrInvalidCollection, "duplicate minter %s", minter)
+		}
+		seen[minter] = true
+	}
+	return nil
+}
+
+// CanMint reports whether the address may mint nfts into the collection.
+func (c Collection) CanMint(addr string) bool {
+	switch c.MintPermission {
+	case MintPermissionAnyone:
+		return true
+	case MintPermissionAllowlist:
+		if addr == c.Creator {
+			return true
+		}
+		for _, minter := range c.Minters {
+			if minter == addr {
+				return true
+			}
+		}
+		return false
+	default:
+		return addr == c.Creator
+	}
+}
+
+func (c Collection) String() string {
+	return fmt.Sprintf(`
+ID: %s,
+Name: %s,
+Description: %s,
+Schema: %s,
+Creator: %s,
+MintPermission: %s,
+Minters: %s
+`, c.ID, c.Name, c.Description, c.Schema, c.Creator, c.MintPermission, strings.Join(c.Minters, ","))
+}
+
+func GetCollectionNFTID(collectionID string, count uint64) string {
+	return collectionID + "-" + strconv.Itoa(int(count))
+}
@@ -0,0 +1,113 @@
+package types
+
+import (
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"math/big"
+	"strings"
+	
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	HashAlgorithmSHA256 = "sha256"
+	HashAlgorithmCIDv0  = "cidv0"
+	HashAlgorithmCIDv1  = "cidv1"
+	
+	multihashSHA256 = 0x12
+	sha256Length    = 32
+	
+	base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+)
+
+var cidBase32 = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+func IsValidHashAlgorithm(algorithm string) bool {
+	switch algorithm {
+	case HashAlgorithmSHA256, HashAlgorithmCIDv0, HashAlgorithmCIDv1:
+		return true
+	default:
+		return false
+	}
+}
+
+// DetectHashAlgorithm guesses the algorithm of a content hash from its format, it is used when
+// no algorithm was selected.
+func DetectHashAlgorithm(hash string) string {
+	switch {
+	case len(hash) == 2*sha256Length:
+		return HashAlgorithmSHA256
+	case strings.HasPrefix(hash, "Qm"):
+		return HashAlgorithmCIDv0
+	case strings.HasPrefix(hash, "b"):
+		return HashAlgorithmCIDv1
+	default:
+		return ""
+	}
+}
+
+// ParseContentHash decodes the hash with the algorithm and returns it in its canonical form, a
+// lower case hex sha256 digest, a base58 CIDv0 or a base32 CIDv1.
+func ParseContentHash(algorithm, hash string) (string, error) {
+	switch algorithm {
+	case HashAlgorithmSHA256:
+		hash = strings.ToLower(hash)
+		if bz, err := hex.DecodeString(hash); err != nil || len(bz) != sha256Length {
+			return "", sdkerrors.Wrapf(ErrInvalidMetadata, "content hash %s is not a hex sha256 digest", hash)
+		}
+	case HashAlgorithmCIDv0:
+		bz, err := decodeBase58(hash)
+		if err != nil || len(bz) != 2+sha256Length || bz[0] != multihashSHA256 || bz[1] != sha256Length {
+			return "", sdkerrors.Wrapf(ErrInvalidMetadata, "content hash %s is not a CIDv0", hash)
+		}
+	case HashAlgorithmCIDv1:
+		hash = strings.ToLower(hash)
+		if !strings.HasPrefix(hash, "b") || !isValidCIDv1(strings.TrimPrefix(hash, "b")) {
+			return "", sdkerrors.Wrapf(ErrInvalidMetadata, "content hash %s is not a base32 CIDv1", hash)
+		}
+	default:
+		return "", sdkerrors.Wrapf(ErrInvalidMetadata, "unknown hash algorithm %s", algorithm)
+	}
+	return hash, nil
+}
+
+// isValidCIDv1 checks the base32 body of a CIDv1 holds the version, a content codec and a
+// multihash whose digest has the announced length.
+func isValidCIDv1(body string) bool {
+	bz, err := cidBase32.DecodeString(strings.ToUpper(body))
+	if err != nil {
+		return false
+	}
+	
+	var fields [4]uint64
+	for i := range fields {
+		value, n := binary.Uvarint(bz)
+		if n <= 0 {
+			return false
+		}
+		fields[i], bz = value, bz[n:]
+	}
+	
+	version, digestLength := fields[0], fields[3]
+	return version == 1 && digestLength > 0 && uint64(len(bz)) == digestLength
+}
+
+func decodeBase58(s string) ([]byte, error) {
+	value := new(big.Int)
+	radix := big.NewInt(int64(len(base58Alphabet)))
+	for _, c := range s {
+		digit := strings.IndexRune(base58Alphabet, c)
+		if digit < 0 {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid base58 character %c", c)
+		}
+		value.Mul(value, radix)
+		value.Add(value, big.NewInt(int64(digit)))
+	}
+	
+	var zeros int
+	for zeros < len(s) && s[zeros] == base58Alphabet[0] {
+		zeros++
+	}
+	return append(make([]byte, zeros), value.Bytes()...), nil
+}
@@ -0,0 +1,113 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+const (
+	ProposalActionLicense     = "license"
+	ProposalActionTransfer    = "transfer"
+	ProposalActionUpdateTerms = "update_terms"
+	ProposalActionBurn        = "burn"
+)
+
+// CoOwnedAddress is the primary owner of a co-owned nft, no key controls it so every action on
+// the nft goes through a proposal approved by the co-owners.
+func CoOwnedAddress(primaryNFTID string) sdk.AccAddress {
+	return authtypes.NewModuleAddress(ModuleName + "/" + primaryNFTID)
+}
+
+func (nft BaseTweetNFT) IsCoOwned() bool {
+	return len(nft.CoOwners) != 0
+}
+
+func (nft BaseTweetNFT) IsCoOwner(addr string) bool {
+	for _, coOwner := range nft.CoOwners {
+		if coOwner == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// Proposal is an action on a co-owned nft waiting for the approval of its co-owners, it executes
+// once the approvals reach the threshold of the nft and is dropped at Expiry otherwise.
+type Proposal struct {
+	ID           uint64        `json:"id"`
+	PrimaryNFTID string        `json:"primary_nft_id"`
+	Proposer     string        `json:"proposer"`
+	Action       string        `json:"action"`
+	Recipient    string        `json:"recipient"`
+	Channel      string        `json:"channel"`
+	LicenseTerms *LicenseTerms `json:"license_terms"`
+	LicensingFee sdk.Coin      `json:"licensing_fee"`
+	RevenueShare sdk.Dec       `json:"revenue_share"`
+	MaxLicensees uint64        `json:"max_licensees"`
+	Approvals    []string      `json:"approvals"`
+	Expiry       time.Time     `json:"expiry"`
+}
+
+func NewProposal(id uint64, msg MsgSubmitProposal, expiry time.Time) Proposal {
+	return Proposal{
+		ID:           id,
+		PrimaryNFTID: msg.PrimaryNFTID,
+		Proposer:     msg.Sender.String(),
+		Action:       msg.Action,
+		Recipient:    msg.Recipient,
+		Channel:      msg.Channel,
+		LicenseTerms: msg.LicenseTerms,
+		LicensingFee: msg.LicensingFee,
+		RevenueShare: msg.RevenueShare,
+		MaxLicensees: msg.MaxLicensees,
+		Approvals:    []string{msg.Sender.String()},
+		Expiry:       expiry,
+	}
+}
+
+func (p Proposal) HasApproved(addr string) bool {
+	for _, approval := range p.Approvals {
+		if approval == addr {
+			return true
+		}
+	}
+	return false
+}
+
+func (p Proposal) String() string {
+	return fmt.Sprintf(`
+ID: %d,
+PrimaryNFTID: %s,
+Proposer: %s,
+Action: %s,
+Recipient: %s,
+Channel: %s,
+LicenseTerms: %s,
+LicensingFee: %s,
+RevenueShare: %s,
+MaxLicensees: %d,
+Approvals: %s,
+Expiry: %s
+`, p.ID, p.PrimaryNFTID, p.Proposer, p.Action, p.Recipient, p.Channel, p.LicenseTerms, p.LicensingFee,
+		p.RevenueShare, p.MaxLicensees, strings.Join(p.Approvals, ","), p.Expiry)
+}
+
+// LicenseApproval lets any co-owner send the license approved by a proposal, it is consumed by
+// the license transfer.
+type LicenseApproval struct {
+	PrimaryNFTID string `json:"primary_nft_id"`
+	Channel      string `json:"channel"`
+	Recipient    string `json:"recipient"`
+}
+
+func NewLicenseApproval(primaryNFTID, channel, recipient string) LicenseApproval {
+	return LicenseApproval{
+		PrimaryNFTID: primaryNFTID,
+		Channel:      channel,
+		Recipient:    recipient,
+	}
+}
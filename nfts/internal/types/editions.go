@@ -0,0 +1,49 @@
+package types
+
+import (
+	"strconv"
+)
+
+// MaxEditionSupply bounds the number of editions a master can be minted in.
+const MaxEditionSupply = 100000
+
+// IsMaster reports whether numbered editions can be minted out of the nft.
+func (nft BaseTweetNFT) IsMaster() bool {
+	return nft.MaxEditions > 0
+}
+
+func (nft BaseTweetNFT) IsEdition() bool {
+	return nft.MasterNFTID != ""
+}
+
+// HasEditionsLeft reports whether the master has not yet reached its maximum supply.
+func (nft BaseTweetNFT) HasEditionsLeft() bool {
+	return nft.IsMaster() && nft.EditionCount < nft.MaxEditions
+}
+
+// Edition derives the edition numbered number out of the master, it shares the asset, the
+// license terms and the revenue share of the master but is owned on its own. The revenue splits
+// of the master owner are left out, the edition owner sets its own.
+func (nft BaseTweetNFT) Edition(number uint64, owner string) BaseTweetNFT {
+	return BaseTweetNFT{
+		PrimaryNFTID:  GetEditionNFTID(nft.PrimaryNFTID, number),
+		PrimaryOwner:  owner,
+		Creator:       nft.Creator,
+		RoyaltyRate:   nft.RoyaltyRate,
+		LicenseTerms:  nft.LicenseTerms,
+		AssetID:       nft.AssetID,
+		LicensingFee:  nft.LicensingFee,
+		RevenueShare:  nft.RevenueShare,
+		MaxLicensees:  nft.MaxLicensees,
+		TwitterHandle: nft.TwitterHandle,
+		Metadata:      nft.Metadata,
+		Platform:      nft.Platform,
+		CollectionID:  nft.CollectionID,
+		MasterNFTID:   nft.PrimaryNFTID,
+		EditionNumber: number,
+	}
+}
+
+func GetEditionNFTID(masterNFTID string, number uint64) string {
+	return masterNFTID + "-e" + strconv.Itoa(int(number))
+}
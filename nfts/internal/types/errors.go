@@ -8,4 +8,45 @@ var (
 	
 	ErrInvalidLicense = sdkerrors.Register(ModuleName, 13, "invalid license")
 	ErrParamsNotFound = sdkerrors.Register(ModuleName, 14, "params not found")
+	
+	ErrLicenseCapReached = sdkerrors.Register(ModuleName, 15, "license cap reached")
+	
+	ErrListingNotFound      = sdkerrors.Register(ModuleName, 16, "listing not found")
+	ErrListingAlreadyExists = sdkerrors.Register(ModuleName, 17, "listing already exists")
+	
+	ErrInvalidRoyaltyRate = sdkerrors.Register(ModuleName, 18, "invalid royalty rate")
+	
+	ErrAuctionNotFound = sdkerrors.Register(ModuleName, 19, "auction not found")
+	ErrAuctionClosed   = sdkerrors.Register(ModuleName, 20, "auction closed")
+	ErrInvalidBid      = sdkerrors.Register(ModuleName, 21, "invalid bid")
+	ErrInvalidAuction  = sdkerrors.Register(ModuleName, 22, "invalid auction")
+	
+	ErrInvalidRevenueSplits = sdkerrors.Register(ModuleName, 23, "invalid revenue splits")
+	
+	ErrProposalNotFound = sdkerrors.Register(ModuleName, 24, "proposal not found")
+	ErrInvalidProposal  = sdkerrors.Register(ModuleName, 25, "invalid proposal")
+	ErrInvalidCoOwners  = sdkerrors.Register(ModuleName, 26, "invalid co-owners")
+	
+	ErrNFTApprovalNotFound = sdkerrors.Register(ModuleName, 27, "nft approval not found")
+	
+	ErrBundleNotFound = sdkerrors.Register(ModuleName, 28, "bundle not found")
+	ErrInvalidBundle  = sdkerrors.Register(ModuleName, 29, "invalid bundle")
+	
+	ErrInvalidMetadata = sdkerrors.Register(ModuleName, 30, "invalid metadata")
+	
+	ErrCollectionNotFound = sdkerrors.Register(ModuleName, 31, "collection not found")
+	ErrInvalidCollection  = sdkerrors.Register(ModuleName, 32, "invalid collection")
+	
+	ErrInvalidEdition       = sdkerrors.Register(ModuleName, 33, "invalid edition")
+	ErrEditionSupplyReached = sdkerrors.Register(ModuleName, 34, "edition supply reached")
+	
+	ErrContentHashAlreadyExist = sdkerrors.Register(ModuleName, 35, "content hash already exist")
+	
+	ErrAttestationNotFound = sdkerrors.Register(ModuleName, 36, "handle attestation not found")
+	ErrInvalidAttestation  = sdkerrors.Register(ModuleName, 37, "invalid handle attestation")
+	
+	ErrProfileNotFound = sdkerrors.Register(ModuleName, 38, "profile not found")
+	ErrInvalidProfile  = sdkerrors.Register(ModuleName, 39, "invalid profile")
+	
+	ErrNFTEscrowed = sdkerrors.Register(ModuleName, 40, "nft is escrowed")
 )
@@ -1,11 +1,65 @@
 package types
 
 var (
-	EventTypeMsgMintTweetNFT = "msg_mint_tweet_nft"
+	EventTypeMsgMintTweetNFT        = "msg_mint_tweet_nft"
+	EventTypeMsgUpdateLicenseCap    = "msg_update_license_cap"
+	EventTypeMsgListTweetNFT        = "msg_list_tweet_nft"
+	EventTypeMsgDelistTweetNFT      = "msg_delist_tweet_nft"
+	EventTypeMsgBuyTweetNFT         = "msg_buy_tweet_nft"
+	EventTypeMsgTransferTweetNFT    = "msg_transfer_tweet_nft"
+	EventTypeMsgCreateAuction       = "msg_create_auction"
+	EventTypeMsgPlaceBid            = "msg_place_bid"
+	EventTypeMsgCancelAuction       = "msg_cancel_auction"
+	EventTypeAuctionSettled         = "auction_settled"
+	EventTypeMsgUpdateRevenueSplits = "msg_update_revenue_splits"
+	EventTypeMsgSetCoOwners         = "msg_set_co_owners"
+	EventTypeMsgSubmitProposal      = "msg_submit_proposal"
+	EventTypeMsgApproveProposal     = "msg_approve_proposal"
+	EventTypeProposalExecuted       = "proposal_executed"
+	EventTypeProposalExpired        = "proposal_expired"
+	EventTypeMsgApproveNFT          = "msg_approve_nft"
+	EventTypeMsgRevokeNFTApproval   = "msg_revoke_nft_approval"
+	EventTypeMsgSetOperator         = "msg_set_operator"
+	EventTypeMsgCreateBundle        = "msg_create_bundle"
+	EventTypeMsgDeleteBundle        = "msg_delete_bundle"
+	EventTypeMsgUpdateNFTMetadata   = "msg_update_nft_metadata"
+	EventTypeMsgCreateCollection    = "msg_create_collection"
+	EventTypeMsgMintEdition         = "msg_mint_edition"
+	EventTypeMsgAttestHandle        = "msg_attest_handle"
+	EventTypeMsgRevokeAttestation   = "msg_revoke_handle_attestation"
+	EventTypeMsgSetProfile          = "msg_set_profile"
 	
 	AttributePrimaryNFTID   = "primary_nft_id"
 	AttributeSecondaryNFTID = "secondary_nft_id"
 	
 	AttributeAssetID       = "asset_id"
 	AttributeTwitterHandle = "twitter_handler"
+	AttributeMaxLicensees  = "max_licensees"
+	AttributeSeller        = "seller"
+	AttributeBuyer         = "buyer"
+	AttributeRecipient     = "recipient"
+	AttributePrice         = "price"
+	AttributeCreator       = "creator"
+	AttributeRoyalty       = "royalty"
+	AttributeAuctionID     = "auction_id"
+	AttributeAuctionKind   = "auction_kind"
+	AttributeBid           = "bid"
+	AttributeLicensee      = "licensee"
+	AttributeRevenueSplits = "revenue_splits"
+	AttributeCoOwners      = "co_owners"
+	AttributeThreshold     = "threshold"
+	AttributeProposalID    = "proposal_id"
+	AttributeAction        = "action"
+	AttributeApproved      = "approved"
+	AttributeOperator      = "operator"
+	AttributeBundleID      = "bundle_id"
+	AttributeContentHash   = "content_hash"
+	AttributePlatform      = "platform"
+	AttributeCollectionID  = "collection_id"
+	AttributeMasterNFTID   = "master_nft_id"
+	AttributeEditionNumber = "edition_number"
+	AttributeMaxEditions   = "max_editions"
+	AttributeVerifier      = "verifier"
+	AttributeAttested      = "attested_address"
+	AttributeVerified      = "verified"
 )
@@ -0,0 +1,14 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type BankKeeper interface {
+	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// NFTHooks lets the modules tracking the licenses of an nft refuse its burn.
+type NFTHooks interface {
+	BeforeNFTBurn(ctx sdk.Context, primaryNFTID string) error
+}
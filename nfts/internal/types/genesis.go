@@ -1,13 +1,54 @@
 package types
 
+import "fmt"
+
 type GenesisState struct {
-	TweetNFTs []BaseTweetNFT `json:"tweet_nfts"`
+	Params           Params              `json:"params"`
+	TweetNFTs        []BaseTweetNFT      `json:"tweet_nfts"`
+	LicenseGrants    []LicenseGrant      `json:"license_grants"`
+	Listings         []Listing           `json:"listings"`
+	Auctions         []Auction           `json:"auctions"`
+	AuctionCount     uint64              `json:"auction_count"`
+	Proposals        []Proposal          `json:"proposals"`
+	ProposalCount    uint64              `json:"proposal_count"`
+	LicenseApprovals []LicenseApproval   `json:"license_approvals"`
+	NFTApprovals     []NFTApproval       `json:"nft_approvals"`
+	Operators        []OperatorApproval  `json:"operators"`
+	Bundles          []Bundle            `json:"bundles"`
+	BundleCount      uint64              `json:"bundle_count"`
+	Collections      []Collection        `json:"collections"`
+	Attestations     []HandleAttestation `json:"attestations"`
+	Profiles         []Profile           `json:"profiles"`
+	GlobalTweetCount uint64              `json:"global_tweet_count"`
 }
 
 func DefaultGenesisState() GenesisState {
-	return GenesisState{}
+	return GenesisState{Params: DefaultParams()}
 }
 
 func (gs GenesisState) ValidateGenesis() error {
-	return nil // TODO Validate
+	for _, nft := range gs.TweetNFTs {
+		if nft.Platform != "" && !IsValidPlatform(nft.Platform) {
+			return fmt.Errorf("nft %s%s has unknown platform %s", nft.PrimaryNFTID, nft.SecondaryNFTID, nft.Platform)
+		}
+	}
+	
+	for _, collection := range gs.Collections {
+		if err := collection.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	
+	for _, attestation := range gs.Attestations {
+		if err := attestation.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	
+	for _, profile := range gs.Profiles {
+		if err := profile.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return gs.Params.Validate()
 }
@@ -2,6 +2,7 @@ package types
 
 import (
 	"strconv"
+	"time"
 	
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -23,6 +24,24 @@ var (
 	GlobalTweetCountPrefix = []byte{0x01}
 	TweetAccountPrefix     = []byte{0x02}
 	TweetNFTPrefix         = []byte{0x03}
+	LicenseGrantPrefix     = []byte{0x04}
+	ListingPrefix          = []byte{0x05}
+	AuctionPrefix          = []byte{0x06}
+	AuctionCountKey        = []byte{0x07}
+	AuctionQueuePrefix     = []byte{0x08}
+	ProposalPrefix         = []byte{0x09}
+	ProposalCountKey       = []byte{0x0A}
+	ProposalQueuePrefix    = []byte{0x0B}
+	LicenseApprovalPrefix  = []byte{0x0C}
+	NFTApprovalPrefix      = []byte{0x0D}
+	OperatorPrefix         = []byte{0x0E}
+	BundlePrefix           = []byte{0x0F}
+	BundleCountKey         = []byte{0x10}
+	CollectionPrefix       = []byte{0x11}
+	ContentHashPrefix      = []byte{0x12}
+	AttestationPrefix      = []byte{0x13}
+	ProfilePrefix          = []byte{0x14}
+	EscrowPrefix           = []byte{0x15}
 )
 
 func GetGlobalTweetCountKey() []byte {
@@ -33,6 +52,82 @@ func GetTweetNFTKey(id []byte) []byte {
 	return append(TweetNFTPrefix, id...)
 }
 
+func GetLicenseGrantsKey(primaryNFTID string) []byte {
+	return append(LicenseGrantPrefix, []byte(primaryNFTID+"/")...)
+}
+
+func GetLicenseGrantKey(primaryNFTID, channel, secondaryNFTID string) []byte {
+	return append(GetLicenseGrantsKey(primaryNFTID), []byte(channel+"/"+secondaryNFTID)...)
+}
+
+func GetListingKey(primaryNFTID string) []byte {
+	return append(ListingPrefix, []byte(primaryNFTID)...)
+}
+
+func GetEscrowKey(primaryNFTID string) []byte {
+	return append(EscrowPrefix, []byte(primaryNFTID)...)
+}
+
+func GetAuctionKey(id uint64) []byte {
+	return append(AuctionPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+func GetAuctionQueueTimeKey(kind string, endTime time.Time) []byte {
+	return append(append(AuctionQueuePrefix, []byte(kind+"/")...), sdk.FormatTimeBytes(endTime)...)
+}
+
+func GetAuctionQueueKey(kind string, endTime time.Time, id uint64) []byte {
+	return append(GetAuctionQueueTimeKey(kind, endTime), sdk.Uint64ToBigEndian(id)...)
+}
+
+func GetProposalKey(id uint64) []byte {
+	return append(ProposalPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+func GetProposalQueueTimeKey(expiry time.Time) []byte {
+	return append(ProposalQueuePrefix, sdk.FormatTimeBytes(expiry)...)
+}
+
+func GetProposalQueueKey(expiry time.Time, id uint64) []byte {
+	return append(GetProposalQueueTimeKey(expiry), sdk.Uint64ToBigEndian(id)...)
+}
+
+func GetLicenseApprovalKey(primaryNFTID, channel, recipient string) []byte {
+	return append(LicenseApprovalPrefix, []byte(primaryNFTID+"/"+channel+"/"+recipient)...)
+}
+
+func GetNFTApprovalKey(primaryNFTID string) []byte {
+	return append(NFTApprovalPrefix, []byte(primaryNFTID)...)
+}
+
+func GetOperatorsKey(owner string) []byte {
+	return append(OperatorPrefix, []byte(owner+"/")...)
+}
+
+func GetOperatorKey(owner, operator string) []byte {
+	return append(GetOperatorsKey(owner), []byte(operator)...)
+}
+
+func GetBundleKey(id uint64) []byte {
+	return append(BundlePrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+func GetCollectionKey(id string) []byte {
+	return append(CollectionPrefix, []byte(id)...)
+}
+
+func GetHandleAttestationKey(platform, handle string) []byte {
+	return append(AttestationPrefix, []byte(platform+"/"+handle)...)
+}
+
+func GetProfileKey(platform, handle string) []byte {
+	return append(ProfilePrefix, []byte(platform+"/"+handle)...)
+}
+
+func GetContentHashKey(hash string) []byte {
+	return append(ContentHashPrefix, []byte(hash)...)
+}
+
 func GetTweetsCountOfAddressKey(addr []byte) []byte {
 	return append(TweetAccountPrefix, addr...)
 }
@@ -0,0 +1,33 @@
+package types
+
+import (
+	"fmt"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// ModuleAddress holds the listed and auctioned nfts and the escrowed bids until they are settled.
+var ModuleAddress = authtypes.NewModuleAddress(ModuleName)
+
+type Listing struct {
+	PrimaryNFTID string   `json:"primary_nft_id"`
+	Seller       string   `json:"seller"`
+	Price        sdk.Coin `json:"price"`
+}
+
+func NewListing(primaryNFTID, seller string, price sdk.Coin) Listing {
+	return Listing{
+		PrimaryNFTID: primaryNFTID,
+		Seller:       seller,
+		Price:        price,
+	}
+}
+
+func (l Listing) String() string {
+	return fmt.Sprintf(`
+PrimaryNFTID: %s,
+Seller: %s,
+Price: %s
+`, l.PrimaryNFTID, l.Seller, l.Price)
+}
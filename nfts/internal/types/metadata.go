@@ -0,0 +1,173 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	MediaTypeVideo = "video"
+	MediaTypeAudio = "audio"
+	MediaTypeImage = "image"
+	MediaTypeText  = "text"
+	
+	MaxTitleLength       = 140
+	MaxDescriptionLength = 2000
+	MaxContentURILength  = 512
+	MaxTags              = 10
+	MaxTagLength         = 32
+)
+
+var (
+	languageRegex = regexp.MustCompile(`^[a-z]{2,3}(-[A-Z]{2})?$`)
+	tagRegex      = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+)
+
+// Metadata describes the media behind a tweet nft, the content hash can not change once minted.
+type Metadata struct {
+	Title       string   `json:"title"`
+	Description string   `json:"description"`
+	MediaType   string   `json:"media_type"`
+	Duration    uint64   `json:"duration"`
+	ContentURI  string   `json:"content_uri"`
+	ContentHash string   `json:"content_hash"`
+	Language    string   `json:"language"`
+	Tags        []string `json:"tags"`
+	
+	// HashAlgorithm is the algorithm of ContentHash, metadata without one has it detected
+	HashAlgorithm string `json:"hash_algorithm"`
+}
+
+func NewMetadata(title, description, mediaType string, duration uint64, contentURI, contentHash, hashAlgorithm,
+	language string, tags []string) Metadata {
+	metadata := Metadata{
+		Title:         strings.TrimSpace(title),
+		Description:   strings.TrimSpace(description),
+		MediaType:     strings.ToLower(strings.TrimSpace(mediaType)),
+		Duration:      duration,
+		ContentURI:    strings.TrimSpace(contentURI),
+		ContentHash:   strings.TrimSpace(contentHash),
+		Language:      strings.TrimSpace(language),
+		Tags:          NormalizeTags(tags),
+		HashAlgorithm: strings.ToLower(strings.TrimSpace(hashAlgorithm)),
+	}
+	
+	if metadata.ContentHash != "" && metadata.HashAlgorithm == "" {
+		metadata.HashAlgorithm = DetectHashAlgorithm(metadata.ContentHash)
+	}
+	if hash, err := ParseContentHash(metadata.HashAlgorithm, metadata.ContentHash); err == nil {
+		metadata.ContentHash = hash
+	}
+	return metadata
+}
+
+// NormalizeTags lower cases the tags and drops empty ones.
+func NormalizeTags(tags []string) []string {
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if tag = strings.ToLower(strings.TrimSpace(tag)); tag != "" {
+			normalized = append(normalized, tag)
+		}
+	}
+	return normalized
+}
+
+func IsValidMediaType(mediaType string) bool {
+	switch mediaType {
+	case MediaTypeVideo, MediaTypeAudio, MediaTypeImage, MediaTypeText:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsValidContentHash accepts a hex encoded sha256 digest, a CIDv0 or a base32 CIDv1.
+func IsValidContentHash(hash string) bool {
+	_, err := ParseContentHash(DetectHashAlgorithm(hash), hash)
+	return err == nil
+}
+
+// ContentHashAlgorithm is the algorithm of the content hash, the selected one or the detected one.
+func (m Metadata) ContentHashAlgorithm() string {
+	if m.HashAlgorithm != "" {
+		return m.HashAlgorithm
+	}
+	return DetectHashAlgorithm(m.ContentHash)
+}
+
+// ValidateBasic checks the fields that are set, nfts minted without metadata carry none.
+func (m Metadata) ValidateBasic() error {
+	if len(m.Title) > MaxTitleLength {
+		return sdkerrors.Wrapf(ErrInvalidMetadata, "title can not exceed %d characters", MaxTitleLength)
+	} else if len(m.Description) > MaxDescriptionLength {
+		return sdkerrors.Wrapf(ErrInvalidMetadata, "description can not exceed %d characters", MaxDescriptionLength)
+	} else if len(m.ContentURI) > MaxContentURILength {
+		return sdkerrors.Wrapf(ErrInvalidMetadata, "content uri can not exceed %d characters", MaxContentURILength)
+	}
+	
+	if m.MediaType != "" && !IsValidMediaType(m.MediaType) {
+		return sdkerrors.Wrapf(ErrInvalidMetadata, "unknown media type %s", m.MediaType)
+	} else if m.Duration != 0 && m.MediaType != MediaTypeVideo && m.MediaType != MediaTypeAudio {
+		return sdkerrors.Wrap(ErrInvalidMetadata, "only video and audio can have a duration")
+	}
+	
+	if m.ContentURI != "" && !strings.Contains(m.ContentURI, "://") {
+		return sdkerrors.Wrapf(ErrInvalidMetadata, "content uri %s has no scheme", m.ContentURI)
+	} else if m.Language != "" && !languageRegex.MatchString(m.Language) {
+		return sdkerrors.Wrapf(ErrInvalidMetadata, "language %s is not an ISO 639 code", m.Language)
+	}
+	
+	if m.ContentHash != "" {
+		if m.HashAlgorithm != "" && !IsValidHashAlgorithm(m.HashAlgorithm) {
+			return sdkerrors.Wrapf(ErrInvalidMetadata, "unknown hash algorithm %s", m.HashAlgorithm)
+		} else if hash, err := ParseContentHash(m.ContentHashAlgorithm(), m.ContentHash); err != nil {
+			return err
+		} else if hash != m.ContentHash {
+			return sdkerrors.Wrapf(ErrInvalidMetadata, "content hash %s should be given as %s", m.ContentHash, hash)
+		}
+	} else if m.HashAlgorithm != "" {
+		return sdkerrors.Wrap(ErrInvalidMetadata, "hash algorithm is set without a content hash")
+	}
+	
+	if len(m.Tags) > MaxTags {
+		return sdkerrors.Wrapf(ErrInvalidMetadata, "nft can not have more than %d tags", MaxTags)
+	}
+	seen := make(map[string]bool)
+	for _, tag := range m.Tags {
+		if len(tag) > MaxTagLength || !tagRegex.MatchString(tag) {
+			return sdkerrors.Wrapf(ErrInvalidMetadata, "invalid tag %s", tag)
+		} else if seen[tag] {
+			return sdkerrors.Wrapf(ErrInvalidMetadata, "duplicate tag %s", tag)
+		}
+		seen[tag] = true
+	}
+	return nil
+}
+
+func (m Metadata) HasTag(tag string) bool {
+	tag = strings.ToLower(tag)
+	for _, _tag := range m.Tags {
+		if _tag == tag {
+			return true
+		}
+	}
+	return false
+}
+
+func (m Metadata) String() string {
+	return fmt.Sprintf(`
+Title: %s,
+Description: %s,
+MediaType: %s,
+Duration: %d,
+ContentURI: %s,
+ContentHash: %s,
+Language: %s,
+Tags: %s,
+HashAlgorithm: %s
+`, m.Title, m.Description, m.MediaType, m.Duration, m.ContentURI, m.ContentHash, m.Language, strings.Join(m.Tags, ","),
+		m.HashAlgorithm)
+}
@@ -1,27 +1,47 @@
 package types
 
 import (
+	"strings"
+	"time"
+	
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
 )
 
 type MsgMintTweetNFT struct {
 	Sender        sdk.AccAddress `json:"sender"`
+	CollectionID  string         `json:"collection_id"`
 	AssetID       string         `json:"asset_id"`
-	License       bool           `json:"license"`
+	LicenseTerms  *LicenseTerms  `json:"license_terms"`
 	LicensingFee  sdk.Coin       `json:"licensing_fee"`
 	RevenueShare  sdk.Dec        `json:"revenue_share"`
+	MaxLicensees  uint64         `json:"max_licensees"`
+	MaxEditions   uint64         `json:"max_editions"`
+	RoyaltyRate   sdk.Dec        `json:"royalty_rate"`
+	RevenueSplits []RevenueSplit `json:"revenue_splits"`
 	TwitterHandle string         `json:"twitter_handle"`
+	Metadata      Metadata       `json:"metadata"`
+	Platform      string         `json:"platform"`
 }
 
-func NewMsgMintNFT(sender sdk.AccAddress, assetID string, license bool, fee sdk.Coin, share sdk.Dec, handle string) MsgMintTweetNFT {
+func NewMsgMintNFT(sender sdk.AccAddress, collectionID, assetID string, terms *LicenseTerms, fee sdk.Coin, share sdk.Dec, maxLicensees,
+	maxEditions uint64, royaltyRate sdk.Dec, splits []RevenueSplit, platform, handle string, metadata Metadata) MsgMintTweetNFT {
+	platform = NormalizePlatform(platform)
 	return MsgMintTweetNFT{
 		Sender:        sender,
+		CollectionID:  collectionID,
 		AssetID:       assetID,
-		License:       license,
+		LicenseTerms:  terms,
 		LicensingFee:  fee,
 		RevenueShare:  share,
-		TwitterHandle: handle,
+		MaxLicensees:  maxLicensees,
+		MaxEditions:   maxEditions,
+		RoyaltyRate:   royaltyRate,
+		RevenueSplits: splits,
+		TwitterHandle: NormalizeHandle(platform, handle),
+		Metadata:      metadata,
+		Platform:      platform,
 	}
 }
 
@@ -43,17 +63,38 @@ func (m MsgMintTweetNFT) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "asset id should not be empty")
 	}
 	
-	if m.License {
+	if m.CollectionID != "" {
+		if err := ValidateCollectionID(m.CollectionID); err != nil {
+			return err
+		}
+	}
+	
+	if m.LicenseTerms != nil {
 		if m.LicensingFee.IsZero() {
 			return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "invalid licensing fee provided")
 		} else if m.RevenueShare.IsZero() {
 			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "revenue share should not be nil")
+		} else if err := m.LicenseTerms.ValidateBasic(); err != nil {
+			return err
 		}
 	}
-	if m.TwitterHandle == "" {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "twitter handle should not be empty")
+	if !m.RoyaltyRate.IsNil() && (m.RoyaltyRate.IsNegative() || m.RoyaltyRate.GT(sdk.OneDec())) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "royalty rate should be between 0 and 1")
+	} else if m.MaxEditions > MaxEditionSupply {
+		return sdkerrors.Wrapf(ErrInvalidEdition, "max editions can not exceed %d", MaxEditionSupply)
 	}
-	return nil
+	if err := ValidateRevenueSplits(m.RevenueSplits); err != nil {
+		return err
+	}
+	platform := NormalizePlatform(m.Platform)
+	if !IsValidPlatform(platform) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unknown platform %s", m.Platform)
+	} else if err := ValidateHandle(platform, NormalizeHandle(platform, m.TwitterHandle)); err != nil {
+		return err
+	} else if err := ValidatePostID(platform, m.AssetID); err != nil {
+		return err
+	}
+	return m.Metadata.ValidateBasic()
 }
 
 func (m MsgMintTweetNFT) GetSignBytes() []byte {
@@ -63,3 +104,1193 @@ func (m MsgMintTweetNFT) GetSignBytes() []byte {
 func (m MsgMintTweetNFT) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{m.Sender}
 }
+
+// --------------------------------------------------------------------
+
+const MaxBatchMintEntries = 100
+
+// MintEntry is a single tweet nft of a batch mint.
+type MintEntry struct {
+	CollectionID  string         `json:"collection_id"`
+	AssetID       string         `json:"asset_id"`
+	LicenseTerms  *LicenseTerms  `json:"license_terms"`
+	LicensingFee  sdk.Coin       `json:"licensing_fee"`
+	RevenueShare  sdk.Dec        `json:"revenue_share"`
+	MaxLicensees  uint64         `json:"max_licensees"`
+	MaxEditions   uint64         `json:"max_editions"`
+	RoyaltyRate   sdk.Dec        `json:"royalty_rate"`
+	RevenueSplits []RevenueSplit `json:"revenue_splits"`
+	TwitterHandle string         `json:"twitter_handle"`
+	Metadata      Metadata       `json:"metadata"`
+	Platform      string         `json:"platform"`
+}
+
+func (entry MintEntry) ToMsgMintTweetNFT(sender sdk.AccAddress) MsgMintTweetNFT {
+	return NewMsgMintNFT(sender, entry.CollectionID, entry.AssetID, entry.LicenseTerms, entry.LicensingFee, entry.RevenueShare,
+		entry.MaxLicensees, entry.MaxEditions, entry.RoyaltyRate, entry.RevenueSplits, entry.Platform, entry.TwitterHandle, entry.Metadata)
+}
+
+type MsgBatchMintTweetNFTs struct {
+	Sender  sdk.AccAddress `json:"sender"`
+	Entries []MintEntry    `json:"entries"`
+}
+
+func NewMsgBatchMintTweetNFTs(sender sdk.AccAddress, entries []MintEntry) MsgBatchMintTweetNFTs {
+	return MsgBatchMintTweetNFTs{
+		Sender:  sender,
+		Entries: entries,
+	}
+}
+
+var _ sdk.Msg = MsgBatchMintTweetNFTs{}
+
+func (m MsgBatchMintTweetNFTs) Route() string {
+	return RouterKey
+}
+
+func (m MsgBatchMintTweetNFTs) Type() string {
+	return "msg_batch_mint_tweet_nfts"
+}
+
+func (m MsgBatchMintTweetNFTs) ValidateBasic() error {
+	if m.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	} else if len(m.Entries) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "entries should not be empty")
+	} else if len(m.Entries) > MaxBatchMintEntries {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "batch can not exceed %d entries", MaxBatchMintEntries)
+	}
+	
+	seen := make(map[string]bool)
+	seenHashes := make(map[string]bool)
+	for i, entry := range m.Entries {
+		if err := entry.ToMsgMintTweetNFT(m.Sender).ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "entry %d", i)
+		}
+		
+		assetID := strings.ToLower(entry.AssetID)
+		if seen[assetID] {
+			return sdkerrors.Wrapf(ErrAssetIDAlreadyExist, "entry %d repeats asset id %s", i, entry.AssetID)
+		}
+		seen[assetID] = true
+		
+		if hash := entry.Metadata.ContentHash; hash != "" {
+			if seenHashes[hash] {
+				return sdkerrors.Wrapf(ErrContentHashAlreadyExist, "entry %d repeats content hash %s", i, hash)
+			}
+			seenHashes[hash] = true
+		}
+	}
+	return nil
+}
+
+func (m MsgBatchMintTweetNFTs) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgBatchMintTweetNFTs) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
+
+// --------------------------------------------------------------------
+
+type MsgUpdateLicenseCap struct {
+	Sender       sdk.AccAddress `json:"sender"`
+	PrimaryNFTID string         `json:"primary_nft_id"`
+	MaxLicensees uint64         `json:"max_licensees"`
+}
+
+func NewMsgUpdateLicenseCap(sender sdk.AccAddress, primaryNFTID string, maxLicensees uint64) MsgUpdateLicenseCap {
+	return MsgUpdateLicenseCap{
+		Sender:       sender,
+		PrimaryNFTID: primaryNFTID,
+		MaxLicensees: maxLicensees,
+	}
+}
+
+var _ sdk.Msg = MsgUpdateLicenseCap{}
+
+func (m MsgUpdateLicenseCap) Route() string {
+	return RouterKey
+}
+
+func (m MsgUpdateLicenseCap) Type() string {
+	return "msg_update_license_cap"
+}
+
+func (m MsgUpdateLicenseCap) ValidateBasic() error {
+	if m.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	} else if m.PrimaryNFTID == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "primary nft id should not be empty")
+	}
+	return nil
+}
+
+func (m MsgUpdateLicenseCap) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgUpdateLicenseCap) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
+
+type MsgListTweetNFT struct {
+	Sender       sdk.AccAddress `json:"sender"`
+	PrimaryNFTID string         `json:"primary_nft_id"`
+	Price        sdk.Coin       `json:"price"`
+}
+
+func NewMsgListTweetNFT(sender sdk.AccAddress, primaryNFTID string, price sdk.Coin) MsgListTweetNFT {
+	return MsgListTweetNFT{
+		Sender:       sender,
+		PrimaryNFTID: primaryNFTID,
+		Price:        price,
+	}
+}
+
+var _ sdk.Msg = MsgListTweetNFT{}
+
+func (m MsgListTweetNFT) Route() string {
+	return RouterKey
+}
+
+func (m MsgListTweetNFT) Type() string {
+	return "msg_list_tweet_nft"
+}
+
+func (m MsgListTweetNFT) ValidateBasic() error {
+	if m.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	} else if m.PrimaryNFTID == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "primary nft id should not be empty")
+	} else if !m.Price.IsValid() || m.Price.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "price should be positive")
+	}
+	return nil
+}
+
+func (m MsgListTweetNFT) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgListTweetNFT) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
+
+type MsgDelistTweetNFT struct {
+	Sender       sdk.AccAddress `json:"sender"`
+	PrimaryNFTID string         `json:"primary_nft_id"`
+}
+
+func NewMsgDelistTweetNFT(sender sdk.AccAddress, primaryNFTID string) MsgDelistTweetNFT {
+	return MsgDelistTweetNFT{
+		Sender:       sender,
+		PrimaryNFTID: primaryNFTID,
+	}
+}
+
+var _ sdk.Msg = MsgDelistTweetNFT{}
+
+func (m MsgDelistTweetNFT) Route() string {
+	return RouterKey
+}
+
+func (m MsgDelistTweetNFT) Type() string {
+	return "msg_delist_tweet_nft"
+}
+
+func (m MsgDelistTweetNFT) ValidateBasic() error {
+	if m.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	} else if m.PrimaryNFTID == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "primary nft id should not be empty")
+	}
+	return nil
+}
+
+func (m MsgDelistTweetNFT) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgDelistTweetNFT) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
+
+type MsgBuyTweetNFT struct {
+	Buyer        sdk.AccAddress `json:"buyer"`
+	PrimaryNFTID string         `json:"primary_nft_id"`
+	Price        sdk.Coin       `json:"price"`
+}
+
+func NewMsgBuyTweetNFT(buyer sdk.AccAddress, primaryNFTID string, price sdk.Coin) MsgBuyTweetNFT {
+	return MsgBuyTweetNFT{
+		Buyer:        buyer,
+		PrimaryNFTID: primaryNFTID,
+		Price:        price,
+	}
+}
+
+var _ sdk.Msg = MsgBuyTweetNFT{}
+
+func (m MsgBuyTweetNFT) Route() string {
+	return RouterKey
+}
+
+func (m MsgBuyTweetNFT) Type() string {
+	return "msg_buy_tweet_nft"
+}
+
+func (m MsgBuyTweetNFT) ValidateBasic() error {
+	if m.Buyer.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid buyer address")
+	} else if m.PrimaryNFTID == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "primary nft id should not be empty")
+	} else if !m.Price.IsValid() || m.Price.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "price should be positive")
+	}
+	return nil
+}
+
+func (m MsgBuyTweetNFT) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgBuyTweetNFT) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Buyer}
+}
+
+type MsgTransferTweetNFT struct {
+	Sender       sdk.AccAddress `json:"sender"`
+	PrimaryNFTID string         `json:"primary_nft_id"`
+	Recipient    sdk.AccAddress `json:"recipient"`
+}
+
+func NewMsgTransferTweetNFT(sender sdk.AccAddress, primaryNFTID string, recipient sdk.AccAddress) MsgTransferTweetNFT {
+	return MsgTransferTweetNFT{
+		Sender:       sender,
+		PrimaryNFTID: primaryNFTID,
+		Recipient:    recipient,
+	}
+}
+
+var _ sdk.Msg = MsgTransferTweetNFT{}
+
+func (m MsgTransferTweetNFT) Route() string {
+	return RouterKey
+}
+
+func (m MsgTransferTweetNFT) Type() string {
+	return "msg_transfer_tweet_nft"
+}
+
+func (m MsgTransferTweetNFT) ValidateBasic() error {
+	if m.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	} else if m.Recipient.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid recipient address")
+	} else if m.PrimaryNFTID == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "primary nft id should not be empty")
+	}
+	return nil
+}
+
+func (m MsgTransferTweetNFT) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgTransferTweetNFT) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
+
+type MsgCreateAuction struct {
+	Sender          sdk.AccAddress `json:"sender"`
+	PrimaryNFTID    string         `json:"primary_nft_id"`
+	Kind            string         `json:"kind"`
+	AuctionType     string         `json:"auction_type"`
+	StartPrice      sdk.Coin       `json:"start_price"`
+	FloorPrice      sdk.Coin       `json:"floor_price"`
+	DurationSeconds int64          `json:"duration_seconds"`
+	Channel         string         `json:"channel"`
+}
+
+func NewMsgCreateAuction(sender sdk.AccAddress, primaryNFTID, kind, auctionType string, startPrice, floorPrice sdk.Coin,
+	durationSeconds int64, channel string) MsgCreateAuction {
+	return MsgCreateAuction{
+		Sender:          sender,
+		PrimaryNFTID:    primaryNFTID,
+		Kind:            kind,
+		AuctionType:     auctionType,
+		StartPrice:      startPrice,
+		FloorPrice:      floorPrice,
+		DurationSeconds: durationSeconds,
+		Channel:         channel,
+	}
+}
+
+var _ sdk.Msg = MsgCreateAuction{}
+
+func (m MsgCreateAuction) Route() string {
+	return RouterKey
+}
+
+func (m MsgCreateAuction) Type() string {
+	return "msg_create_auction"
+}
+
+func (m MsgCreateAuction) ValidateBasic() error {
+	if m.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	} else if m.PrimaryNFTID == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "primary nft id should not be empty")
+	} else if !m.StartPrice.IsValid() || m.StartPrice.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "start price should be positive")
+	} else if m.DurationSeconds <= 0 {
+		return sdkerrors.Wrap(ErrInvalidAuction, "duration should be positive")
+	}
+	
+	switch m.Kind {
+	case AuctionKindOwnership:
+	case AuctionKindLicense:
+		if err := host.ChannelIdentifierValidator(m.Channel); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidAuction, "invalid channel %s", m.Channel)
+		}
+	default:
+		return sdkerrors.Wrapf(ErrInvalidAuction, "unknown auction kind %s", m.Kind)
+	}
+	
+	switch m.AuctionType {
+	case AuctionTypeEnglish:
+	case AuctionTypeDutch:
+		if !m.FloorPrice.IsValid() || m.FloorPrice.Denom != m.StartPrice.Denom {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "floor price should be in the start price denom")
+		} else if !m.FloorPrice.IsLT(m.StartPrice) {
+			return sdkerrors.Wrap(ErrInvalidAuction, "floor price should be lower than start price")
+		}
+	default:
+		return sdkerrors.Wrapf(ErrInvalidAuction, "unknown auction type %s", m.AuctionType)
+	}
+	return nil
+}
+
+func (m MsgCreateAuction) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgCreateAuction) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
+
+type MsgPlaceBid struct {
+	Bidder    sdk.AccAddress `json:"bidder"`
+	AuctionID uint64         `json:"auction_id"`
+	Amount    sdk.Coin       `json:"amount"`
+	Licensee  string         `json:"licensee"`
+}
+
+func NewMsgPlaceBid(bidder sdk.AccAddress, auctionID uint64, amount sdk.Coin, licensee string) MsgPlaceBid {
+	return MsgPlaceBid{
+		Bidder:    bidder,
+		AuctionID: auctionID,
+		Amount:    amount,
+		Licensee:  licensee,
+	}
+}
+
+var _ sdk.Msg = MsgPlaceBid{}
+
+func (m MsgPlaceBid) Route() string {
+	return RouterKey
+}
+
+func (m MsgPlaceBid) Type() string {
+	return "msg_place_bid"
+}
+
+func (m MsgPlaceBid) ValidateBasic() error {
+	if m.Bidder.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid bidder address")
+	} else if !m.Amount.IsValid() || m.Amount.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "bid should be positive")
+	}
+	return nil
+}
+
+func (m MsgPlaceBid) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgPlaceBid) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Bidder}
+}
+
+type MsgCancelAuction struct {
+	Sender    sdk.AccAddress `json:"sender"`
+	AuctionID uint64         `json:"auction_id"`
+}
+
+func NewMsgCancelAuction(sender sdk.AccAddress, auctionID uint64) MsgCancelAuction {
+	return MsgCancelAuction{
+		Sender:    sender,
+		AuctionID: auctionID,
+	}
+}
+
+var _ sdk.Msg = MsgCancelAuction{}
+
+func (m MsgCancelAuction) Route() string {
+	return RouterKey
+}
+
+func (m MsgCancelAuction) Type() string {
+	return "msg_cancel_auction"
+}
+
+func (m MsgCancelAuction) ValidateBasic() error {
+	if m.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+	return nil
+}
+
+func (m MsgCancelAuction) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgCancelAuction) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
+
+type MsgUpdateRevenueSplits struct {
+	Sender        sdk.AccAddress `json:"sender"`
+	PrimaryNFTID  string         `json:"primary_nft_id"`
+	RevenueSplits []RevenueSplit `json:"revenue_splits"`
+}
+
+func NewMsgUpdateRevenueSplits(sender sdk.AccAddress, primaryNFTID string, splits []RevenueSplit) MsgUpdateRevenueSplits {
+	return MsgUpdateRevenueSplits{
+		Sender:        sender,
+		PrimaryNFTID:  primaryNFTID,
+		RevenueSplits: splits,
+	}
+}
+
+var _ sdk.Msg = MsgUpdateRevenueSplits{}
+
+func (m MsgUpdateRevenueSplits) Route() string {
+	return RouterKey
+}
+
+func (m MsgUpdateRevenueSplits) Type() string {
+	return "msg_update_revenue_splits"
+}
+
+func (m MsgUpdateRevenueSplits) ValidateBasic() error {
+	if m.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	} else if m.PrimaryNFTID == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "primary nft id should not be empty")
+	}
+	return ValidateRevenueSplits(m.RevenueSplits)
+}
+
+func (m MsgUpdateRevenueSplits) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgUpdateRevenueSplits) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
+
+type MsgSetCoOwners struct {
+	Sender       sdk.AccAddress   `json:"sender"`
+	PrimaryNFTID string           `json:"primary_nft_id"`
+	CoOwners     []sdk.AccAddress `json:"co_owners"`
+	Threshold    uint64           `json:"threshold"`
+}
+
+func NewMsgSetCoOwners(sender sdk.AccAddress, primaryNFTID string, coOwners []sdk.AccAddress, threshold uint64) MsgSetCoOwners {
+	return MsgSetCoOwners{
+		Sender:       sender,
+		PrimaryNFTID: primaryNFTID,
+		CoOwners:     coOwners,
+		Threshold:    threshold,
+	}
+}
+
+var _ sdk.Msg = MsgSetCoOwners{}
+
+func (m MsgSetCoOwners) Route() string {
+	return RouterKey
+}
+
+func (m MsgSetCoOwners) Type() string {
+	return "msg_set_co_owners"
+}
+
+func (m MsgSetCoOwners) ValidateBasic() error {
+	if m.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	} else if m.PrimaryNFTID == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "primary nft id should not be empty")
+	} else if len(m.CoOwners) < 2 {
+		return sdkerrors.Wrap(ErrInvalidCoOwners, "at least two co-owners are required")
+	} else if m.Threshold == 0 || m.Threshold > uint64(len(m.CoOwners)) {
+		return sdkerrors.Wrapf(ErrInvalidCoOwners, "threshold should be between 1 and %d", len(m.CoOwners))
+	}
+	
+	seen := make(map[string]bool)
+	for _, coOwner := range m.CoOwners {
+		if coOwner.Empty() {
+			return sdkerrors.Wrap(ErrInvalidCoOwners, "co-owner address should not be empty")
+		} else if seen[coOwner.String()] {
+			return sdkerrors.Wrapf(ErrInvalidCoOwners, "duplicate co-owner %s", coOwner)
+		}
+		seen[coOwner.String()] = true
+	}
+	return nil
+}
+
+func (m MsgSetCoOwners) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgSetCoOwners) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
+
+type MsgSubmitProposal struct {
+	Sender       sdk.AccAddress `json:"sender"`
+	PrimaryNFTID string         `json:"primary_nft_id"`
+	Action       string         `json:"action"`
+	Recipient    string         `json:"recipient"`
+	Channel      string         `json:"channel"`
+	LicenseTerms *LicenseTerms  `json:"license_terms"`
+	LicensingFee sdk.Coin       `json:"licensing_fee"`
+	RevenueShare sdk.Dec        `json:"revenue_share"`
+	MaxLicensees uint64         `json:"max_licensees"`
+}
+
+func NewMsgSubmitProposal(sender sdk.AccAddress, primaryNFTID, action, recipient, channel string, terms *LicenseTerms,
+	fee sdk.Coin, share sdk.Dec, maxLicensees uint64) MsgSubmitProposal {
+	return MsgSubmitProposal{
+		Sender:       sender,
+		PrimaryNFTID: primaryNFTID,
+		Action:       action,
+		Recipient:    recipient,
+		Channel:      channel,
+		LicenseTerms: terms,
+		LicensingFee: fee,
+		RevenueShare: share,
+		MaxLicensees: maxLicensees,
+	}
+}
+
+var _ sdk.Msg = MsgSubmitProposal{}
+
+func (m MsgSubmitProposal) Route() string {
+	return RouterKey
+}
+
+func (m MsgSubmitProposal) Type() string {
+	return "msg_submit_proposal"
+}
+
+func (m MsgSubmitProposal) ValidateBasic() error {
+	if m.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	} else if m.PrimaryNFTID == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "primary nft id should not be empty")
+	}
+	
+	switch m.Action {
+	case ProposalActionLicense:
+		if m.Recipient == "" {
+			return sdkerrors.Wrap(ErrInvalidProposal, "licensee should not be empty")
+		} else if err := host.ChannelIdentifierValidator(m.Channel); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidProposal, "invalid channel %s", m.Channel)
+		}
+	case ProposalActionTransfer:
+		if _, err := sdk.AccAddressFromBech32(m.Recipient); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidProposal, "invalid recipient %s", m.Recipient)
+		}
+	case ProposalActionUpdateTerms:
+		if m.LicenseTerms != nil {
+			if !m.LicensingFee.IsValid() || m.LicensingFee.IsZero() {
+				return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "invalid licensing fee provided")
+			} else if m.RevenueShare.IsNil() || m.RevenueShare.IsZero() {
+				return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "revenue share should not be nil")
+			}
+			return m.LicenseTerms.ValidateBasic()
+		}
+	case ProposalActionBurn:
+	default:
+		return sdkerrors.Wrapf(ErrInvalidProposal, "unknown action %s", m.Action)
+	}
+	return nil
+}
+
+func (m MsgSubmitProposal) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgSubmitProposal) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
+
+type MsgApproveProposal struct {
+	Sender     sdk.AccAddress `json:"sender"`
+	ProposalID uint64         `json:"proposal_id"`
+}
+
+func NewMsgApproveProposal(sender sdk.AccAddress, proposalID uint64) MsgApproveProposal {
+	return MsgApproveProposal{
+		Sender:     sender,
+		ProposalID: proposalID,
+	}
+}
+
+var _ sdk.Msg = MsgApproveProposal{}
+
+func (m MsgApproveProposal) Route() string {
+	return RouterKey
+}
+
+func (m MsgApproveProposal) Type() string {
+	return "msg_approve_proposal"
+}
+
+func (m MsgApproveProposal) ValidateBasic() error {
+	if m.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+	return nil
+}
+
+func (m MsgApproveProposal) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgApproveProposal) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
+
+type MsgApproveNFT struct {
+	Sender       sdk.AccAddress `json:"sender"`
+	PrimaryNFTID string         `json:"primary_nft_id"`
+	Approved     sdk.AccAddress `json:"approved"`
+}
+
+func NewMsgApproveNFT(sender sdk.AccAddress, primaryNFTID string, approved sdk.AccAddress) MsgApproveNFT {
+	return MsgApproveNFT{
+		Sender:       sender,
+		PrimaryNFTID: primaryNFTID,
+		Approved:     approved,
+	}
+}
+
+var _ sdk.Msg = MsgApproveNFT{}
+
+func (m MsgApproveNFT) Route() string {
+	return RouterKey
+}
+
+func (m MsgApproveNFT) Type() string {
+	return "msg_approve_nft"
+}
+
+func (m MsgApproveNFT) ValidateBasic() error {
+	if m.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	} else if m.Approved.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid approved address")
+	} else if m.Sender.Equals(m.Approved) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "sender can not approve itself")
+	} else if m.PrimaryNFTID == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "primary nft id should not be empty")
+	}
+	return nil
+}
+
+func (m MsgApproveNFT) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgApproveNFT) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
+
+type MsgRevokeNFTApproval struct {
+	Sender       sdk.AccAddress `json:"sender"`
+	PrimaryNFTID string         `json:"primary_nft_id"`
+}
+
+func NewMsgRevokeNFTApproval(sender sdk.AccAddress, primaryNFTID string) MsgRevokeNFTApproval {
+	return MsgRevokeNFTApproval{
+		Sender:       sender,
+		PrimaryNFTID: primaryNFTID,
+	}
+}
+
+var _ sdk.Msg = MsgRevokeNFTApproval{}
+
+func (m MsgRevokeNFTApproval) Route() string {
+	return RouterKey
+}
+
+func (m MsgRevokeNFTApproval) Type() string {
+	return "msg_revoke_nft_approval"
+}
+
+func (m MsgRevokeNFTApproval) ValidateBasic() error {
+	if m.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	} else if m.PrimaryNFTID == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "primary nft id should not be empty")
+	}
+	return nil
+}
+
+func (m MsgRevokeNFTApproval) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgRevokeNFTApproval) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
+
+type MsgSetOperator struct {
+	Sender   sdk.AccAddress `json:"sender"`
+	Operator sdk.AccAddress `json:"operator"`
+	Approved bool           `json:"approved"`
+}
+
+func NewMsgSetOperator(sender, operator sdk.AccAddress, approved bool) MsgSetOperator {
+	return MsgSetOperator{
+		Sender:   sender,
+		Operator: operator,
+		Approved: approved,
+	}
+}
+
+var _ sdk.Msg = MsgSetOperator{}
+
+func (m MsgSetOperator) Route() string {
+	return RouterKey
+}
+
+func (m MsgSetOperator) Type() string {
+	return "msg_set_operator"
+}
+
+func (m MsgSetOperator) ValidateBasic() error {
+	if m.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	} else if m.Operator.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid operator address")
+	} else if m.Sender.Equals(m.Operator) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "sender can not be its own operator")
+	}
+	return nil
+}
+
+func (m MsgSetOperator) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgSetOperator) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
+
+// --------------------------------------------------------------------
+
+type MsgCreateBundle struct {
+	Sender       sdk.AccAddress `json:"sender"`
+	Name         string         `json:"name"`
+	Members      []BundleMember `json:"members"`
+	Price        sdk.Coin       `json:"price"`
+	LicenseTerms LicenseTerms   `json:"license_terms"`
+	RevenueShare sdk.Dec        `json:"revenue_share"`
+}
+
+func NewMsgCreateBundle(sender sdk.AccAddress, name string, members []BundleMember, price sdk.Coin,
+	terms LicenseTerms, share sdk.Dec) MsgCreateBundle {
+	return MsgCreateBundle{
+		Sender:       sender,
+		Name:         name,
+		Members:      members,
+		Price:        price,
+		LicenseTerms: terms,
+		RevenueShare: share,
+	}
+}
+
+var _ sdk.Msg = MsgCreateBundle{}
+
+func (m MsgCreateBundle) Route() string {
+	return RouterKey
+}
+
+func (m MsgCreateBundle) Type() string {
+	return "msg_create_bundle"
+}
+
+func (m MsgCreateBundle) ValidateBasic() error {
+	if m.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	} else if m.Name == "" {
+		return sdkerrors.Wrap(ErrInvalidBundle, "name should not be empty")
+	} else if !m.Price.IsValid() || m.Price.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "bundle price should be positive")
+	} else if m.RevenueShare.IsNil() || m.RevenueShare.IsNegative() || m.RevenueShare.GT(sdk.OneDec()) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "revenue share should be between 0 and 1")
+	}
+	
+	if err := m.LicenseTerms.ValidateBasic(); err != nil {
+		return err
+	}
+	return ValidateBundleMembers(m.Members)
+}
+
+func (m MsgCreateBundle) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgCreateBundle) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
+
+type MsgDeleteBundle struct {
+	Sender   sdk.AccAddress `json:"sender"`
+	BundleID uint64         `json:"bundle_id"`
+}
+
+func NewMsgDeleteBundle(sender sdk.AccAddress, bundleID uint64) MsgDeleteBundle {
+	return MsgDeleteBundle{
+		Sender:   sender,
+		BundleID: bundleID,
+	}
+}
+
+var _ sdk.Msg = MsgDeleteBundle{}
+
+func (m MsgDeleteBundle) Route() string {
+	return RouterKey
+}
+
+func (m MsgDeleteBundle) Type() string {
+	return "msg_delete_bundle"
+}
+
+func (m MsgDeleteBundle) ValidateBasic() error {
+	if m.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+	return nil
+}
+
+func (m MsgDeleteBundle) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgDeleteBundle) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
+
+// --------------------------------------------------------------------
+
+// MsgUpdateNFTMetadata replaces the metadata of a primary nft, the content hash it was minted
+// with can not be changed.
+type MsgUpdateNFTMetadata struct {
+	Sender       sdk.AccAddress `json:"sender"`
+	PrimaryNFTID string         `json:"primary_nft_id"`
+	Metadata     Metadata       `json:"metadata"`
+}
+
+func NewMsgUpdateNFTMetadata(sender sdk.AccAddress, primaryNFTID string, metadata Metadata) MsgUpdateNFTMetadata {
+	return MsgUpdateNFTMetadata{
+		Sender:       sender,
+		PrimaryNFTID: primaryNFTID,
+		Metadata:     metadata,
+	}
+}
+
+var _ sdk.Msg = MsgUpdateNFTMetadata{}
+
+func (m MsgUpdateNFTMetadata) Route() string {
+	return RouterKey
+}
+
+func (m MsgUpdateNFTMetadata) Type() string {
+	return "msg_update_nft_metadata"
+}
+
+func (m MsgUpdateNFTMetadata) ValidateBasic() error {
+	if m.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	} else if m.PrimaryNFTID == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "primary nft id should not be empty")
+	}
+	return m.Metadata.ValidateBasic()
+}
+
+func (m MsgUpdateNFTMetadata) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgUpdateNFTMetadata) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
+
+// --------------------------------------------------------------------
+
+type MsgCreateCollection struct {
+	Sender         sdk.AccAddress `json:"sender"`
+	ID             string         `json:"id"`
+	Name           string         `json:"name"`
+	Description    string         `json:"description"`
+	Schema         string         `json:"schema"`
+	MintPermission string         `json:"mint_permission"`
+	Minters        []string       `json:"minters"`
+}
+
+func NewMsgCreateCollection(sender sdk.AccAddress, id, name, description, schema, permission string,
+	minters []string) MsgCreateCollection {
+	return MsgCreateCollection{
+		Sender:         sender,
+		ID:             id,
+		Name:           name,
+		Description:    description,
+		Schema:         schema,
+		MintPermission: permission,
+		Minters:        minters,
+	}
+}
+
+var _ sdk.Msg = MsgCreateCollection{}
+
+func (m MsgCreateCollection) Route() string {
+	return RouterKey
+}
+
+func (m MsgCreateCollection) Type() string {
+	return "msg_create_collection"
+}
+
+func (m MsgCreateCollection) ValidateBasic() error {
+	if m.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+	return NewCollection(m.ID, m.Name, m.Description, m.Schema, m.Sender.String(), m.MintPermission, m.Minters).ValidateBasic()
+}
+
+func (m MsgCreateCollection) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgCreateCollection) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
+
+// --------------------------------------------------------------------
+
+type MsgMintEdition struct {
+	Sender      sdk.AccAddress `json:"sender"`
+	MasterNFTID string         `json:"master_nft_id"`
+	Recipient   sdk.AccAddress `json:"recipient"`
+}
+
+func NewMsgMintEdition(sender sdk.AccAddress, masterNFTID string, recipient sdk.AccAddress) MsgMintEdition {
+	return MsgMintEdition{
+		Sender:      sender,
+		MasterNFTID: masterNFTID,
+		Recipient:   recipient,
+	}
+}
+
+var _ sdk.Msg = MsgMintEdition{}
+
+func (m MsgMintEdition) Route() string {
+	return RouterKey
+}
+
+func (m MsgMintEdition) Type() string {
+	return "msg_mint_edition"
+}
+
+func (m MsgMintEdition) ValidateBasic() error {
+	if m.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	} else if m.Recipient.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid recipient address")
+	} else if m.MasterNFTID == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "master nft id should not be empty")
+	}
+	return nil
+}
+
+func (m MsgMintEdition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgMintEdition) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
+
+// --------------------------------------------------------------------
+
+// MsgAttestHandle binds the handle to the address for ExpirySeconds from the block it is
+// included in, 0 for an attestation that holds until revoked.
+type MsgAttestHandle struct {
+	Verifier      sdk.AccAddress `json:"verifier"`
+	Platform      string         `json:"platform"`
+	Handle        string         `json:"handle"`
+	Address       sdk.AccAddress `json:"address"`
+	ExpirySeconds int64          `json:"expiry_seconds"`
+}
+
+func NewMsgAttestHandle(verifier sdk.AccAddress, platform, handle string, address sdk.AccAddress,
+	expirySeconds int64) MsgAttestHandle {
+	platform = NormalizePlatform(platform)
+	return MsgAttestHandle{
+		Verifier:      verifier,
+		Platform:      platform,
+		Handle:        NormalizeHandle(platform, handle),
+		Address:       address,
+		ExpirySeconds: expirySeconds,
+	}
+}
+
+var _ sdk.Msg = MsgAttestHandle{}
+
+func (m MsgAttestHandle) Route() string {
+	return RouterKey
+}
+
+func (m MsgAttestHandle) Type() string {
+	return "msg_attest_handle"
+}
+
+func (m MsgAttestHandle) ValidateBasic() error {
+	if m.Verifier.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid verifier address")
+	} else if m.Address.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid attested address")
+	} else if m.ExpirySeconds < 0 {
+		return sdkerrors.Wrap(ErrInvalidAttestation, "expiry seconds can not be negative")
+	}
+	return m.Attestation(time.Time{}).ValidateBasic()
+}
+
+func (m MsgAttestHandle) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgAttestHandle) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Verifier}
+}
+
+// Attestation is the attestation recorded by the message at the block time now.
+func (m MsgAttestHandle) Attestation(now time.Time) HandleAttestation {
+	var expiry time.Time
+	if m.ExpirySeconds > 0 {
+		expiry = now.Add(time.Duration(m.ExpirySeconds) * time.Second)
+	}
+	return NewHandleAttestation(m.Platform, m.Handle, m.Address.String(), m.Verifier.String(), expiry)
+}
+
+// --------------------------------------------------------------------
+
+type MsgRevokeHandleAttestation struct {
+	Verifier sdk.AccAddress `json:"verifier"`
+	Platform string         `json:"platform"`
+	Handle   string         `json:"handle"`
+}
+
+func NewMsgRevokeHandleAttestation(verifier sdk.AccAddress, platform, handle string) MsgRevokeHandleAttestation {
+	platform = NormalizePlatform(platform)
+	return MsgRevokeHandleAttestation{
+		Verifier: verifier,
+		Platform: platform,
+		Handle:   NormalizeHandle(platform, handle),
+	}
+}
+
+var _ sdk.Msg = MsgRevokeHandleAttestation{}
+
+func (m MsgRevokeHandleAttestation) Route() string {
+	return RouterKey
+}
+
+func (m MsgRevokeHandleAttestation) Type() string {
+	return "msg_revoke_handle_attestation"
+}
+
+func (m MsgRevokeHandleAttestation) ValidateBasic() error {
+	if m.Verifier.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid verifier address")
+	} else if !IsValidPlatform(m.Platform) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unknown platform %s", m.Platform)
+	}
+	return ValidateHandle(m.Platform, m.Handle)
+}
+
+func (m MsgRevokeHandleAttestation) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgRevokeHandleAttestation) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Verifier}
+}
+
+// --------------------------------------------------------------------
+
+type MsgSetProfile struct {
+	Sender        sdk.AccAddress `json:"sender"`
+	Platform      string         `json:"platform"`
+	Handle        string         `json:"handle"`
+	DisplayName   string         `json:"display_name"`
+	AvatarURI     string         `json:"avatar_uri"`
+	PayoutAddress string         `json:"payout_address"`
+}
+
+func NewMsgSetProfile(sender sdk.AccAddress, platform, handle, displayName, avatarURI, payoutAddress string) MsgSetProfile {
+	platform = NormalizePlatform(platform)
+	return MsgSetProfile{
+		Sender:        sender,
+		Platform:      platform,
+		Handle:        NormalizeHandle(platform, handle),
+		DisplayName:   strings.TrimSpace(displayName),
+		AvatarURI:     strings.TrimSpace(avatarURI),
+		PayoutAddress: strings.TrimSpace(payoutAddress),
+	}
+}
+
+var _ sdk.Msg = MsgSetProfile{}
+
+func (m MsgSetProfile) Route() string {
+	return RouterKey
+}
+
+func (m MsgSetProfile) Type() string {
+	return "msg_set_profile"
+}
+
+func (m MsgSetProfile) ValidateBasic() error {
+	if m.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	} else if !IsValidPlatform(m.Platform) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unknown platform %s", m.Platform)
+	} else if err := ValidateHandle(m.Platform, m.Handle); err != nil {
+		return err
+	}
+	return ValidateProfileFields(m.DisplayName, m.AvatarURI, m.PayoutAddress)
+}
+
+func (m MsgSetProfile) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgSetProfile) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
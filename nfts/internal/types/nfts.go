@@ -2,24 +2,64 @@ package types
 
 import (
 	"fmt"
+	"strings"
+	"time"
 	
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+const (
+	LicenseStatusActive  = "active"
+	LicenseStatusExpired = "expired"
+)
+
 type BaseTweetNFT struct {
 	PrimaryNFTID string `json:"primary_nft_id"`
 	PrimaryOwner string `json:"primary_owner"`
 	
+	Creator     string  `json:"creator"`
+	RoyaltyRate sdk.Dec `json:"royalty_rate"`
+	
+	CoOwners  []string `json:"co_owners"`
+	Threshold uint64   `json:"threshold"`
+	
 	SecondaryNFTID string `json:"secondary_nft_id"`
 	SecondaryOwner string `json:"secondary_owner"`
 	
-	License bool   `json:"license"`
-	AssetID string `json:"asset_id"`
+	ParentNFTID     string   `json:"parent_nft_id"`
+	ParentChannel   string   `json:"parent_channel"`
+	Licensors       []string `json:"licensors"`
+	SublicenseFee   sdk.Coin `json:"sublicense_fee"`
+	SublicenseShare sdk.Dec  `json:"sublicense_share"`
+	
+	LicenseTerms *LicenseTerms `json:"license_terms"`
+	AssetID      string        `json:"asset_id"`
 	
-	LicensingFee sdk.Coin `json:"licensing_fee"`
-	RevenueShare sdk.Dec  `json:"revenue_share"`
+	LicensingFee  sdk.Coin       `json:"licensing_fee"`
+	RevenueShare  sdk.Dec        `json:"revenue_share"`
+	RevenueSplits []RevenueSplit `json:"revenue_splits"`
+	MaxLicensees  uint64         `json:"max_licensees"`
 	
-	TwitterHandle string `json:"twitter_handle"`
+	LicenseStatus string    `json:"license_status"`
+	ExpiryHeight  int64     `json:"expiry_height"`
+	ExpiryTime    time.Time `json:"expiry_time"`
+	
+	// TwitterHandle is the handle of the author on Platform, named after the first supported one
+	TwitterHandle string   `json:"twitter_handle"`
+	Metadata      Metadata `json:"metadata"`
+	Platform      string   `json:"platform"`
+	CollectionID  string   `json:"collection_id"`
+	
+	// MaxEditions and EditionCount are set on a master, the editions minted out of it point back
+	// to it with MasterNFTID
+	MaxEditions   uint64 `json:"max_editions"`
+	EditionCount  uint64 `json:"edition_count"`
+	MasterNFTID   string `json:"master_nft_id"`
+	EditionNumber uint64 `json:"edition_number"`
+	
+	// License is the licensing flag of the nfts minted before license terms, it is only read
+	// from genesis and replaced by the default terms
+	License bool `json:"license,omitempty"`
 }
 
 func (nft BaseTweetNFT) String() string {
@@ -27,16 +67,140 @@ func (nft BaseTweetNFT) String() string {
 PrimaryNFTID: %s,
 PrimaryOwner: %s,
 
+Creator: %s,
+RoyaltyRate: %s,
+
+CoOwners: %s,
+Threshold: %d,
+
 SecondaryNFTID: %s,
 SecondaryOwner: %s,
 
-License: %t,
+ParentNFTID: %s,
+ParentChannel: %s,
+Licensors: %s,
+SublicenseFee: %s,
+SublicenseShare: %s,
+
+LicenseTerms: %s,
 AssetID: %s,
 
 LicensingFee: %s,
 RevenueShare: %s,
+RevenueSplits: %s,
+MaxLicensees: %d,
+
+LicenseStatus: %s,
+ExpiryHeight: %d,
+ExpiryTime: %s,
 
 TwitterHandle: %s,
-`, nft.PrimaryNFTID, nft.PrimaryOwner, nft.SecondaryNFTID, nft.SecondaryOwner,
-		nft.License, nft.AssetID, nft.LicensingFee.String(), nft.RevenueShare.String(), nft.TwitterHandle)
+Metadata: %s,
+Platform: %s,
+CollectionID: %s,
+
+MaxEditions: %d,
+EditionCount: %d,
+MasterNFTID: %s,
+EditionNumber: %d,
+`, nft.PrimaryNFTID, nft.PrimaryOwner, nft.Creator, nft.RoyaltyRate,
+		strings.Join(nft.CoOwners, ","), nft.Threshold, nft.SecondaryNFTID, nft.SecondaryOwner,
+		nft.ParentNFTID, nft.ParentChannel, strings.Join(nft.Licensors, ","), nft.SublicenseFee, nft.SublicenseShare,
+		nft.LicenseTerms, nft.AssetID, nft.LicensingFee.String(), nft.RevenueShare.String(), nft.RevenueSplits, nft.MaxLicensees,
+		nft.LicenseStatus, nft.ExpiryHeight, nft.ExpiryTime, nft.TwitterHandle, nft.Metadata, nft.Platform, nft.CollectionID,
+		nft.MaxEditions, nft.EditionCount, nft.MasterNFTID, nft.EditionNumber)
+}
+
+// Royalty is the part of a sale price owed to the creator, the royalty rate is bounded by maxRate
+// and nothing is owed when the creator is the one selling.
+func (nft BaseTweetNFT) Royalty(price sdk.Coin, seller string, maxRate sdk.Dec) sdk.Coin {
+	if nft.Creator == "" || nft.Creator == seller || nft.RoyaltyRate.IsNil() || !nft.RoyaltyRate.IsPositive() {
+		return sdk.NewCoin(price.Denom, sdk.ZeroInt())
+	}
+	
+	rate := nft.RoyaltyRate
+	if rate.GT(maxRate) {
+		rate = maxRate
+	}
+	return sdk.NewCoin(price.Denom, price.Amount.ToDec().Mul(rate).TruncateInt())
+}
+
+// IsLicenseActive reports whether a secondary nft still holds its license, nfts minted
+// before license expiry was introduced carry no status and are active.
+func (nft BaseTweetNFT) IsLicenseActive() bool {
+	return nft.LicenseStatus != LicenseStatusExpired
+}
+
+// SetLicenseExpiry derives the expiry of a secondary nft from its license terms. A subscription
+// license without a subscription behind it is only valid for a single period.
+func (nft *BaseTweetNFT) SetLicenseExpiry(height int64, now time.Time, subscribed bool) {
+	nft.LicenseStatus = LicenseStatusActive
+	if nft.LicenseTerms == nil {
+		return
+	}
+	
+	if nft.LicenseTerms.DurationBlocks != 0 {
+		nft.ExpiryHeight = height + nft.LicenseTerms.DurationBlocks
+	} else if nft.LicenseTerms.DurationSeconds != 0 {
+		nft.ExpiryTime = now.Add(time.Duration(nft.LicenseTerms.DurationSeconds) * time.Second)
+	} else if nft.LicenseTerms.IsSubscription() && !subscribed {
+		nft.ExpiryHeight = height + nft.LicenseTerms.SubscriptionPeriodBlocks
+	}
+}
+
+func (nft BaseTweetNFT) HasLicenseExpiry() bool {
+	return nft.ExpiryHeight != 0 || !nft.ExpiryTime.IsZero()
+}
+
+// LicenseGrant records a single licensee of a primary NFT. A primary NFT can hold
+// any number of grants, bounded only by its MaxLicensees (0 means unlimited).
+type LicenseGrant struct {
+	PrimaryNFTID   string `json:"primary_nft_id"`
+	SecondaryNFTID string `json:"secondary_nft_id"`
+	Licensee       string `json:"licensee"`
+	Channel        string `json:"channel"`
+	GrantHeight    int64  `json:"grant_height"`
+	Status         string `json:"status"`
+	Exclusive      bool   `json:"exclusive"`
+}
+
+func NewLicenseGrant(primaryNFTID, secondaryNFTID, licensee, channel string, height int64) LicenseGrant {
+	return LicenseGrant{
+		PrimaryNFTID:   primaryNFTID,
+		SecondaryNFTID: secondaryNFTID,
+		Licensee:       licensee,
+		Channel:        channel,
+		GrantHeight:    height,
+		Status:         LicenseStatusActive,
+	}
+}
+
+func (grant LicenseGrant) IsActive() bool {
+	return grant.Status != LicenseStatusExpired
+}
+
+func (grant LicenseGrant) String() string {
+	return fmt.Sprintf(`
+PrimaryNFTID: %s,
+SecondaryNFTID: %s,
+Licensee: %s,
+Channel: %s,
+GrantHeight: %d,
+Status: %s,
+Exclusive: %t,
+`, grant.PrimaryNFTID, grant.SecondaryNFTID, grant.Licensee, grant.Channel, grant.GrantHeight, grant.Status,
+		grant.Exclusive)
+}
+
+func (nft BaseTweetNFT) IsLicensable() bool {
+	return nft.LicenseTerms != nil
+}
+
+// LicenseCapReached reports whether the nft can not take another licensee
+// given the number of licensees it already holds.
+func (nft BaseTweetNFT) LicenseCapReached(licensees int) bool {
+	if nft.IsLicensable() && nft.LicenseTerms.Exclusive && licensees >= 1 {
+		return true
+	}
+	return nft.MaxLicensees != 0 && uint64(licensees) >= nft.MaxLicensees
 }
@@ -0,0 +1,165 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+const (
+	DefaultParamspace = ModuleName
+)
+
+var (
+	KeyMaxRoyaltyRate  = []byte("MaxRoyaltyRate")
+	KeyProposalWindow  = []byte("ProposalWindow")
+	KeyMaxLicenseDepth = []byte("MaxLicenseDepth")
+	KeyVerifiers       = []byte("Verifiers")
+	KeyRequireAttested = []byte("RequireHandleAttestation")
+	
+	DefaultMaxRoyaltyRate  = sdk.NewDecWithPrec(10, 2)
+	DefaultProposalWindow  = 7 * 24 * time.Hour
+	DefaultMaxLicenseDepth = uint64(3)
+)
+
+// Params of the nfts module, ProposalWindow bounds how long a co-owner proposal waits for approvals
+// and MaxLicenseDepth bounds how many licensors a sublicense can have above it. Verifiers attest
+// handles to addresses, mints are checked against them when RequireHandleAttestation is set.
+type Params struct {
+	MaxRoyaltyRate           sdk.Dec       `json:"max_royalty_rate"`
+	ProposalWindow           time.Duration `json:"proposal_window"`
+	MaxLicenseDepth          uint64        `json:"max_license_depth"`
+	Verifiers                []string      `json:"verifiers"`
+	RequireHandleAttestation bool          `json:"require_handle_attestation"`
+}
+
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func NewParams(maxRoyaltyRate sdk.Dec, proposalWindow time.Duration, maxLicenseDepth uint64, verifiers []string,
+	requireHandleAttestation bool) Params {
+	return Params{
+		MaxRoyaltyRate:           maxRoyaltyRate,
+		ProposalWindow:           proposalWindow,
+		MaxLicenseDepth:          maxLicenseDepth,
+		Verifiers:                verifiers,
+		RequireHandleAttestation: requireHandleAttestation,
+	}
+}
+
+func DefaultParams() Params {
+	return NewParams(DefaultMaxRoyaltyRate, DefaultProposalWindow, DefaultMaxLicenseDepth, []string{}, false)
+}
+
+func (p Params) IsVerifier(addr string) bool {
+	for _, verifier := range p.Verifiers {
+		if verifier == addr {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMaxRoyaltyRate, &p.MaxRoyaltyRate, validateRate),
+		paramtypes.NewParamSetPair(KeyProposalWindow, &p.ProposalWindow, validateWindow),
+		paramtypes.NewParamSetPair(KeyMaxLicenseDepth, &p.MaxLicenseDepth, validateDepth),
+		paramtypes.NewParamSetPair(KeyVerifiers, &p.Verifiers, validateVerifiers),
+		paramtypes.NewParamSetPair(KeyRequireAttested, &p.RequireHandleAttestation, validateBool),
+	}
+}
+
+func (p Params) Validate() error {
+	if err := validateRate(p.MaxRoyaltyRate); err != nil {
+		return err
+	}
+	if err := validateWindow(p.ProposalWindow); err != nil {
+		return err
+	}
+	if err := validateDepth(p.MaxLicenseDepth); err != nil {
+		return err
+	}
+	if err := validateVerifiers(p.Verifiers); err != nil {
+		return err
+	}
+	if p.RequireHandleAttestation && len(p.Verifiers) == 0 {
+		return fmt.Errorf("handle attestation can not be required without verifiers")
+	}
+	return nil
+}
+
+func (p Params) String() string {
+	return fmt.Sprintf(`
+MaxRoyaltyRate: %s,
+ProposalWindow: %s,
+MaxLicenseDepth: %d,
+Verifiers: %s,
+RequireHandleAttestation: %t
+`, p.MaxRoyaltyRate, p.ProposalWindow, p.MaxLicenseDepth, strings.Join(p.Verifiers, ","), p.RequireHandleAttestation)
+}
+
+func validateRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("rate should be between 0 and 1: %s", v)
+	}
+	return nil
+}
+
+func validateWindow(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	
+	if v <= 0 {
+		return fmt.Errorf("window should be positive: %s", v)
+	}
+	return nil
+}
+
+func validateDepth(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	
+	if v == 0 {
+		return fmt.Errorf("depth should be positive: %d", v)
+	}
+	return nil
+}
+
+func validateVerifiers(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	
+	seen := make(map[string]bool)
+	for _, verifier := range v {
+		if _, err := sdk.AccAddressFromBech32(verifier); err != nil {
+			return fmt.Errorf("invalid verifier %s: %s", verifier, err)
+		} else if seen[verifier] {
+			return fmt.Errorf("duplicate verifier %s", verifier)
+		}
+		seen[verifier] = true
+	}
+	return nil
+}
+
+func validateBool(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
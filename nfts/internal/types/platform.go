@@ -0,0 +1,102 @@
+package types
+
+import (
+	"regexp"
+	"strings"
+	
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	PlatformTwitter   = "twitter"
+	PlatformYouTube   = "youtube"
+	PlatformInstagram = "instagram"
+	PlatformGeneric   = "generic"
+	
+	// DefaultPlatform is the platform of nfts minted before platforms were introduced.
+	DefaultPlatform = PlatformTwitter
+	
+	MaxGenericHandleLength = 64
+	MaxGenericPostIDLength = 128
+)
+
+var (
+	twitterHandleRegex   = regexp.MustCompile(`^[a-z0-9_]{1,15}$`)
+	twitterPostIDRegex   = regexp.MustCompile(`^[0-9]{1,19}$`)
+	youtubeHandleRegex   = regexp.MustCompile(`^[a-z0-9._-]{3,30}$`)
+	youtubePostIDRegex   = regexp.MustCompile(`^[A-Za-z0-9_-]{11}$`)
+	instagramHandleRegex = regexp.MustCompile(`^[a-z0-9_](?:[a-z0-9._]{0,28}[a-z0-9_])?$`)
+	instagramPostIDRegex = regexp.MustCompile(`^[A-Za-z0-9_-]{5,40}$`)
+)
+
+func IsValidPlatform(platform string) bool {
+	switch platform {
+	case PlatformTwitter, PlatformYouTube, PlatformInstagram, PlatformGeneric:
+		return true
+	default:
+		return false
+	}
+}
+
+// NormalizePlatform lower cases the platform, an empty platform is the default one.
+func NormalizePlatform(platform string) string {
+	platform = strings.ToLower(strings.TrimSpace(platform))
+	if platform == "" {
+		return DefaultPlatform
+	}
+	return platform
+}
+
+// NormalizeHandle strips the leading @ of a handle, handles of the known platforms are case
+// insensitive and lower cased.
+func NormalizeHandle(platform, handle string) string {
+	handle = strings.TrimPrefix(strings.TrimSpace(handle), "@")
+	if platform == PlatformGeneric {
+		return handle
+	}
+	return strings.ToLower(handle)
+}
+
+func ValidateHandle(platform, handle string) error {
+	var valid bool
+	switch platform {
+	case PlatformTwitter:
+		valid = twitterHandleRegex.MatchString(handle)
+	case PlatformYouTube:
+		valid = youtubeHandleRegex.MatchString(handle)
+	case PlatformInstagram:
+		valid = instagramHandleRegex.MatchString(handle)
+	case PlatformGeneric:
+		valid = handle != "" && len(handle) <= MaxGenericHandleLength && !strings.ContainsAny(handle, " \t\n")
+	default:
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unknown platform %s", platform)
+	}
+	
+	if !valid {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid %s handle %s", platform, handle)
+	}
+	return nil
+}
+
+// ValidatePostID checks the asset id is the id of a post on the platform, a tweet id on twitter,
+// a video id on youtube and a shortcode on instagram.
+func ValidatePostID(platform, postID string) error {
+	var valid bool
+	switch platform {
+	case PlatformTwitter:
+		valid = twitterPostIDRegex.MatchString(postID)
+	case PlatformYouTube:
+		valid = youtubePostIDRegex.MatchString(postID)
+	case PlatformInstagram:
+		valid = instagramPostIDRegex.MatchString(postID)
+	case PlatformGeneric:
+		valid = postID != "" && len(postID) <= MaxGenericPostIDLength
+	default:
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unknown platform %s", platform)
+	}
+	
+	if !valid {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid %s post id %s", platform, postID)
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	MaxDisplayNameLength = 64
+	MaxAvatarURILength   = 512
+)
+
+// Profile of a creator keyed by the handle on the platform, nfts link to it through their
+// platform and handle so updates of the profile show up without touching the nfts.
+type Profile struct {
+	Platform      string `json:"platform"`
+	Handle        string `json:"handle"`
+	Owner         string `json:"owner"`
+	DisplayName   string `json:"display_name"`
+	AvatarURI     string `json:"avatar_uri"`
+	PayoutAddress string `json:"payout_address"`
+	Verified      bool   `json:"verified"`
+	NFTCount      uint64 `json:"nft_count"`
+}
+
+func NewProfile(platform, handle, owner string) Profile {
+	return Profile{
+		Platform: platform,
+		Handle:   handle,
+		Owner:    owner,
+	}
+}
+
+// Payout is the address the creator wants to be paid at, the owner when none is set.
+func (p Profile) Payout() string {
+	if p.PayoutAddress != "" {
+		return p.PayoutAddress
+	}
+	return p.Owner
+}
+
+func (p Profile) ValidateBasic() error {
+	if !IsValidPlatform(p.Platform) {
+		return sdkerrors.Wrapf(ErrInvalidProfile, "unknown platform %s", p.Platform)
+	} else if err := ValidateHandle(p.Platform, p.Handle); err != nil {
+		return sdkerrors.Wrap(ErrInvalidProfile, err.Error())
+	} else if _, err := sdk.AccAddressFromBech32(p.Owner); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidProfile, "invalid owner %s", p.Owner)
+	}
+	return ValidateProfileFields(p.DisplayName, p.AvatarURI, p.PayoutAddress)
+}
+
+func ValidateProfileFields(displayName, avatarURI, payoutAddress string) error {
+	if len(displayName) > MaxDisplayNameLength {
+		return sdkerrors.Wrapf(ErrInvalidProfile, "display name can not exceed %d characters", MaxDisplayNameLength)
+	} else if len(avatarURI) > MaxAvatarURILength {
+		return sdkerrors.Wrapf(ErrInvalidProfile, "avatar uri can not exceed %d characters", MaxAvatarURILength)
+	} else if avatarURI != "" && !strings.Contains(avatarURI, "://") {
+		return sdkerrors.Wrapf(ErrInvalidProfile, "avatar uri %s has no scheme", avatarURI)
+	}
+	
+	if payoutAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(payoutAddress); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidProfile, "invalid payout address %s", payoutAddress)
+		}
+	}
+	return nil
+}
+
+func (p Profile) String() string {
+	return fmt.Sprintf(`
+Platform: %s,
+Handle: %s,
+Owner: %s,
+DisplayName: %s,
+AvatarURI: %s,
+PayoutAddress: %s,
+Verified: %t,
+NFTCount: %d
+`, p.Platform, p.Handle, p.Owner, p.DisplayName, p.AvatarURI, p.PayoutAddress, p.Verified, p.NFTCount)
+}
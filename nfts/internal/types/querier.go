@@ -3,4 +3,27 @@ package types
 const (
 	QueryTweetNFT           = "tweet_nft"
 	QueryTweetNFTsByAddress = "address_tweet_nfts"
+	QueryLicenseGrants      = "license_grants"
+	QueryListingsBySeller   = "listings_by_seller"
+	QueryListingsByPrice    = "listings_by_price"
+	QueryListingsByHandle   = "listings_by_handle"
+	QueryParams             = "params"
+	QueryAuction            = "auction"
+	QueryAuctions           = "auctions"
+	QueryProposals          = "proposals"
+	QueryNFTApproval        = "nft_approval"
+	QueryOperators          = "operators"
+	QueryBundle             = "bundle"
+	QueryBundles            = "bundles"
+	QueryTweetNFTsByTag     = "tweet_nfts_by_tag"
+	QueryCollection         = "collection"
+	QueryCollections        = "collections"
+	QueryCollectionNFTs     = "collection_nfts"
+	QueryEditions           = "editions"
+	QueryNFTByContentHash   = "nft_by_content_hash"
+	QueryAttestation        = "handle_attestation"
+	QueryAttestations       = "handle_attestations"
+	QueryProfile            = "profile"
+	QueryProfiles           = "profiles"
+	QueryProfileNFTs        = "profile_nfts"
 )
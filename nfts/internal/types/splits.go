@@ -0,0 +1,120 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// RevenueSplit is the weight of a collaborator in the revenue of an nft.
+type RevenueSplit struct {
+	Address string  `json:"address"`
+	Weight  sdk.Dec `json:"weight"`
+}
+
+func NewRevenueSplit(address string, weight sdk.Dec) RevenueSplit {
+	return RevenueSplit{
+		Address: address,
+		Weight:  weight,
+	}
+}
+
+func (split RevenueSplit) String() string {
+	return fmt.Sprintf("%s:%s", split.Address, split.Weight)
+}
+
+// ParseRevenueSplits parses splits in the address:weight,address:weight format.
+func ParseRevenueSplits(str string) ([]RevenueSplit, error) {
+	str = strings.TrimSpace(str)
+	if str == "" {
+		return nil, nil
+	}
+	
+	var splits []RevenueSplit
+	for _, entry := range strings.Split(str, ",") {
+		parts := strings.Split(strings.TrimSpace(entry), ":")
+		if len(parts) != 2 {
+			return nil, sdkerrors.Wrapf(ErrInvalidRevenueSplits, "invalid split %s", entry)
+		}
+		
+		weight, err := sdk.NewDecFromStr(parts[1])
+		if err != nil {
+			return nil, sdkerrors.Wrapf(ErrInvalidRevenueSplits, "invalid weight %s", parts[1])
+		}
+		splits = append(splits, NewRevenueSplit(parts[0], weight))
+	}
+	return splits, nil
+}
+
+// ValidateRevenueSplits checks the collaborators are distinct addresses with positive weights
+// summing to 1, an empty table pays the primary owner alone.
+func ValidateRevenueSplits(splits []RevenueSplit) error {
+	if len(splits) == 0 {
+		return nil
+	}
+	
+	total := sdk.ZeroDec()
+	seen := make(map[string]bool)
+	for _, split := range splits {
+		if _, err := sdk.AccAddressFromBech32(split.Address); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidRevenueSplits, "invalid address %s", split.Address)
+		} else if seen[split.Address] {
+			return sdkerrors.Wrapf(ErrInvalidRevenueSplits, "duplicate address %s", split.Address)
+		} else if split.Weight.IsNil() || !split.Weight.IsPositive() {
+			return sdkerrors.Wrapf(ErrInvalidRevenueSplits, "weight of %s should be positive", split.Address)
+		}
+		
+		seen[split.Address] = true
+		total = total.Add(split.Weight)
+	}
+	
+	if !total.Equal(sdk.OneDec()) {
+		return sdkerrors.Wrapf(ErrInvalidRevenueSplits, "weights sum to %s instead of 1", total)
+	}
+	return nil
+}
+
+// Payout is the part of a revenue owed to a single address.
+type Payout struct {
+	Address sdk.AccAddress
+	Amount  sdk.Coin
+}
+
+// Payouts distributes a revenue of the nft across its split table. Without one a co-owned nft
+// pays its co-owners equally and any other nft pays the primary owner. The rounding dust goes to
+// the first collaborator.
+func (nft BaseTweetNFT) Payouts(amount sdk.Coin) ([]Payout, error) {
+	splits := nft.RevenueSplits
+	if len(splits) == 0 && nft.IsCoOwned() {
+		weight := sdk.OneDec().QuoInt64(int64(len(nft.CoOwners)))
+		for _, coOwner := range nft.CoOwners {
+			splits = append(splits, NewRevenueSplit(coOwner, weight))
+		}
+	}
+	
+	if len(splits) == 0 {
+		owner, err := sdk.AccAddressFromBech32(nft.PrimaryOwner)
+		if err != nil {
+			return nil, err
+		}
+		return []Payout{{Address: owner, Amount: amount}}, nil
+	}
+	
+	payouts := make([]Payout, len(splits))
+	remaining := amount.Amount
+	for i, split := range splits {
+		addr, err := sdk.AccAddressFromBech32(split.Address)
+		if err != nil {
+			return nil, err
+		}
+		
+		share := amount.Amount.ToDec().Mul(split.Weight).TruncateInt()
+		payouts[i] = Payout{Address: addr, Amount: sdk.NewCoin(amount.Denom, share)}
+		remaining = remaining.Sub(share)
+	}
+	
+	payouts[0].Amount = payouts[0].Amount.Add(sdk.NewCoin(amount.Denom, remaining))
+	return payouts, nil
+}
@@ -0,0 +1,87 @@
+package types
+
+import (
+	"bytes"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/tendermint/tendermint/libs/bech32"
+)
+
+// IsSublicense reports whether the secondary nft was granted by another licensee rather than by
+// the primary owner.
+func (nft BaseTweetNFT) IsSublicense() bool {
+	return nft.ParentNFTID != "" && nft.ParentNFTID != nft.PrimaryNFTID
+}
+
+// LicenseDepth is the number of licensors above the secondary nft, a license granted by the
+// primary owner has a depth of 1.
+func (nft BaseTweetNFT) LicenseDepth() uint64 {
+	return uint64(len(nft.Licensors))
+}
+
+// SublicenseLicensors is the chain of licensors of a sublicense granted out of the secondary nft.
+func (nft BaseTweetNFT) SublicenseLicensors() []string {
+	licensors := make([]string, 0, len(nft.Licensors)+1)
+	licensors = append(licensors, nft.Licensors...)
+	return append(licensors, nft.SecondaryOwner)
+}
+
+// IsInLicenseChain reports whether the address already holds the primary nft or a license the
+// secondary nft derives from. Addresses are compared by their bytes as licensors may live on
+// chains with another bech32 prefix.
+func (nft BaseTweetNFT) IsInLicenseChain(addr string) bool {
+	_, bz, err := bech32.DecodeAndConvert(addr)
+	if err != nil {
+		return false
+	}
+	
+	for _, licensor := range append(nft.SublicenseLicensors(), nft.PrimaryOwner) {
+		if _, licensorBz, err := bech32.DecodeAndConvert(licensor); err == nil && bytes.Equal(bz, licensorBz) {
+			return true
+		}
+	}
+	return false
+}
+
+func (nft BaseTweetNFT) IsSublicensable() bool {
+	return nft.SecondaryNFTID != "" && nft.IsLicenseActive() && nft.IsLicensable() &&
+		nft.LicenseTerms.SublicensingAllowed && nft.SublicenseFee.Denom != ""
+}
+
+// ValidateSublicense checks the buyer can take a sublicense of the secondary nft for the fee,
+// sublicenses deeper than maxDepth or back to a licensor of the chain are rejected.
+func (nft BaseTweetNFT) ValidateSublicense(buyer string, fee sdk.Coin, maxDepth uint64) error {
+	if !nft.IsSublicensable() {
+		return sdkerrors.Wrapf(ErrInvalidLicense, "%s can not be sublicensed", nft.SecondaryNFTID)
+	} else if nft.LicenseDepth() >= maxDepth {
+		return sdkerrors.Wrapf(ErrInvalidLicense, "sublicense of %s would exceed the maximum depth of %d", nft.SecondaryNFTID, maxDepth)
+	} else if nft.IsInLicenseChain(buyer) {
+		return sdkerrors.Wrapf(ErrInvalidLicense, "%s already holds a license in the chain of %s", buyer, nft.SecondaryNFTID)
+	} else if fee.Denom != nft.SublicenseFee.Denom || !fee.Amount.Equal(nft.SublicenseFee.Amount) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "sublicense fee should be %s", nft.SublicenseFee)
+	}
+	return nil
+}
+
+// Sublicense derives the secondary nft granted to the buyer out of the secondary nft, it keeps
+// the license terms and owes the sublicense share of its revenue to the parent.
+func (nft BaseTweetNFT) Sublicense(buyer string) BaseTweetNFT {
+	return BaseTweetNFT{
+		PrimaryNFTID:   nft.PrimaryNFTID,
+		PrimaryOwner:   nft.PrimaryOwner,
+		SecondaryOwner: buyer,
+		ParentNFTID:    nft.SecondaryNFTID,
+		Licensors:      nft.SublicenseLicensors(),
+		LicenseTerms:   nft.LicenseTerms,
+		AssetID:        nft.AssetID,
+		LicensingFee:   nft.SublicenseFee,
+		RevenueShare:   nft.SublicenseShare,
+		TwitterHandle:  nft.TwitterHandle,
+		Metadata:       nft.Metadata,
+		Platform:       nft.Platform,
+		CollectionID:   nft.CollectionID,
+		MasterNFTID:    nft.MasterNFTID,
+		EditionNumber:  nft.EditionNumber,
+	}
+}
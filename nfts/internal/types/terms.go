@@ -0,0 +1,86 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
+)
+
+// LicenseTerms describe the rights granted to every licensee of an nft. A zero duration
+// means the license never expires and empty permitted channels means any channel.
+// A non zero subscription period charges the licensing fee once every period.
+type LicenseTerms struct {
+	Exclusive                bool     `json:"exclusive"`
+	DurationBlocks           int64    `json:"duration_blocks"`
+	DurationSeconds          int64    `json:"duration_seconds"`
+	PermittedChannels        []string `json:"permitted_channels"`
+	SublicensingAllowed      bool     `json:"sublicensing_allowed"`
+	CommercialUse            bool     `json:"commercial_use"`
+	SubscriptionPeriodBlocks int64    `json:"subscription_period_blocks"`
+}
+
+func NewLicenseTerms(exclusive bool, durationBlocks, durationSeconds int64, channels []string,
+	sublicensing, commercial bool, subscriptionPeriod int64) LicenseTerms {
+	return LicenseTerms{
+		Exclusive:                exclusive,
+		DurationBlocks:           durationBlocks,
+		DurationSeconds:          durationSeconds,
+		PermittedChannels:        channels,
+		SublicensingAllowed:      sublicensing,
+		CommercialUse:            commercial,
+		SubscriptionPeriodBlocks: subscriptionPeriod,
+	}
+}
+
+func (terms LicenseTerms) IsSubscription() bool {
+	return terms.SubscriptionPeriodBlocks != 0
+}
+
+func DefaultLicenseTerms() LicenseTerms {
+	return LicenseTerms{}
+}
+
+func (terms LicenseTerms) ValidateBasic() error {
+	if terms.DurationBlocks < 0 || terms.DurationSeconds < 0 {
+		return sdkerrors.Wrap(ErrInvalidLicense, "license duration should not be negative")
+	} else if terms.DurationBlocks != 0 && terms.DurationSeconds != 0 {
+		return sdkerrors.Wrap(ErrInvalidLicense, "license duration should be either in blocks or in seconds")
+	} else if terms.SubscriptionPeriodBlocks < 0 {
+		return sdkerrors.Wrap(ErrInvalidLicense, "subscription period should not be negative")
+	}
+	
+	for _, channel := range terms.PermittedChannels {
+		if err := host.ChannelIdentifierValidator(channel); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidLicense, "invalid permitted channel %s", channel)
+		}
+	}
+	return nil
+}
+
+func (terms LicenseTerms) IsChannelPermitted(channel string) bool {
+	if len(terms.PermittedChannels) == 0 {
+		return true
+	}
+	
+	for _, permitted := range terms.PermittedChannels {
+		if permitted == channel {
+			return true
+		}
+	}
+	return false
+}
+
+func (terms LicenseTerms) String() string {
+	return fmt.Sprintf(`
+Exclusive: %t,
+DurationBlocks: %d,
+DurationSeconds: %d,
+PermittedChannels: %s,
+SublicensingAllowed: %t,
+CommercialUse: %t,
+SubscriptionPeriodBlocks: %d,
+`, terms.Exclusive, terms.DurationBlocks, terms.DurationSeconds, strings.Join(terms.PermittedChannels, ","),
+		terms.SublicensingAllowed, terms.CommercialUse, terms.SubscriptionPeriodBlocks)
+}
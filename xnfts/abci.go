@@ -0,0 +1,26 @@
+package xnfts
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	
+	"github.com/FreeFlixMedia/modules/nfts"
+)
+
+// EndBlocker closes the lapsed license offers and grants the licenses won in auctions on the
+// primary chain. On the licensee chain it expires the licenses whose duration has passed, sends
+// the expiry notices again that could not be delivered and collects the subscription payments due.
+func EndBlocker(ctx sdk.Context, k Keeper) {
+	k.ExpireLicenseOffers(ctx)
+	
+	if nfts.GetContextOfCurrentChain() != nfts.CoCoContext {
+		k.SettleLicenseAuctions(ctx)
+		return
+	}
+	
+	for _, license := range k.DequeueExpiredLicenses(ctx) {
+		k.ExpireLicense(ctx, license)
+	}
+	k.RetryLicenseExpiryNotices(ctx)
+	
+	k.CollectSubscriptionPayments(ctx)
+}
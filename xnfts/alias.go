@@ -14,6 +14,35 @@ type (
 	MsgPayLicensingFee                  = types.MsgPayLicensingFee
 	PostCreationPacketAcknowledgement   = types.PostCreationPacketAcknowledgement
 	PacketPayLicensingFeeAndNFTTransfer = types.PacketPayLicensingFeeAndNFTTransfer
+	PacketLicenseExpired                = types.PacketLicenseExpired
+	PacketSubscriptionPayment           = types.PacketSubscriptionPayment
+	MsgSubscribeLicense                 = types.MsgSubscribeLicense
+	MsgTopUpSubscription                = types.MsgTopUpSubscription
+	MsgCancelSubscription               = types.MsgCancelSubscription
+	Subscription                        = types.Subscription
+	MsgMakeLicenseOffer                 = types.MsgMakeLicenseOffer
+	MsgAcceptLicenseOffer               = types.MsgAcceptLicenseOffer
+	MsgRejectLicenseOffer               = types.MsgRejectLicenseOffer
+	PacketLicenseOffer                  = types.PacketLicenseOffer
+	PacketLicenseOfferResponse          = types.PacketLicenseOfferResponse
+	LicenseOffer                        = types.LicenseOffer
+	MsgRedeemLicenseOffer               = types.MsgRedeemLicenseOffer
+	MsgRevokeSignedOffer                = types.MsgRevokeSignedOffer
+	SignedLicenseOffer                  = types.SignedLicenseOffer
+	SignedOfferTerms                    = types.SignedOfferTerms
+	MsgOfferSublicense                  = types.MsgOfferSublicense
+	MsgBuySublicense                    = types.MsgBuySublicense
+	PacketBuySublicense                 = types.PacketBuySublicense
+	PacketSublicenseRevenue             = types.PacketSublicenseRevenue
+	MsgTransferSecondaryNFT             = types.MsgTransferSecondaryNFT
+	PacketLicenseTransferred            = types.PacketLicenseTransferred
+	MsgXNFTBatchTransfer                = types.MsgXNFTBatchTransfer
+	PacketBatchNFTTransfer              = types.PacketBatchNFTTransfer
+	MsgPayBundleLicensingFee            = types.MsgPayBundleLicensingFee
+	PacketPayBundleLicensingFee         = types.PacketPayBundleLicensingFee
+	MsgRedeemMintVoucher                = types.MsgRedeemMintVoucher
+	MintVoucher                         = types.MintVoucher
+	MintVoucherTerms                    = types.MintVoucherTerms
 )
 
 const (
@@ -21,10 +50,17 @@ const (
 	StoreKey     = types.StoreKey
 	RouterKey    = types.RouterKey
 	QuerierRoute = types.QuerierRoute
+	
+	OfferStatusPending  = types.OfferStatusPending
+	OfferStatusAccepted = types.OfferStatusAccepted
+	OfferStatusRejected = types.OfferStatusRejected
+	OfferStatusExpired  = types.OfferStatusExpired
+	OfferStatusRefunded = types.OfferStatusRefunded
 )
 
 var (
 	NewKeeper                              = keeper.NewKeeper
+	NewQuerier                             = keeper.NewQuerier
 	RegisterCodec                          = types.RegisterCodec
 	RegisterInterfaces                     = types.RegisterInterfaces
 	NewMsgXNFTTransfer                     = types.NewMsgXNFTTransfer
@@ -33,4 +69,25 @@ var (
 	AttributeKeyReceiver                   = types.AttributeKeyReceiver
 	EventTypeNFTPacketTransfer             = types.EventTypeNFTPacketTransfer
 	EventTypePayLicensingFeeAndNFTTransfer = types.EventTypePayLicensingFeeAndNFTTransfer
+	EventTypeLicenseExpired                = types.EventTypeLicenseExpired
+	EventTypeSubscribeLicense              = types.EventTypeSubscribeLicense
+	EventTypeTopUpSubscription             = types.EventTypeTopUpSubscription
+	EventTypeCancelSubscription            = types.EventTypeCancelSubscription
+	EventTypeSubscriptionPayment           = types.EventTypeSubscriptionPayment
+	EventTypeMakeLicenseOffer              = types.EventTypeMakeLicenseOffer
+	EventTypeLicenseOfferReceived          = types.EventTypeLicenseOfferReceived
+	EventTypeAcceptLicenseOffer            = types.EventTypeAcceptLicenseOffer
+	EventTypeRejectLicenseOffer            = types.EventTypeRejectLicenseOffer
+	EventTypeLicenseOfferResponse          = types.EventTypeLicenseOfferResponse
+	EventTypeRedeemLicenseOffer            = types.EventTypeRedeemLicenseOffer
+	EventTypeRevokeSignedOffer             = types.EventTypeRevokeSignedOffer
+	EventTypeOfferSublicense               = types.EventTypeOfferSublicense
+	EventTypeBuySublicense                 = types.EventTypeBuySublicense
+	EventTypeSublicenseRevenue             = types.EventTypeSublicenseRevenue
+	EventTypeTransferSecondaryNFT          = types.EventTypeTransferSecondaryNFT
+	EventTypeLicenseTransferred            = types.EventTypeLicenseTransferred
+	EventTypeNFTBatchTransfer              = types.EventTypeNFTBatchTransfer
+	EventTypePayBundleLicensingFee         = types.EventTypePayBundleLicensingFee
+	EventTypeRedeemMintVoucher             = types.EventTypeRedeemMintVoucher
+	EventTypeMintVoucherRedeemed           = types.EventTypeMintVoucherRedeemed
 )
@@ -14,7 +14,17 @@ const (
 	FlagLicensingFee  = "licensing-fee"
 	FlagRevenueShare  = "revenue-share"
 	FlagTwitterHandle = "handle"
+	FlagPlatform      = "platform"
 	FlagAmount        = "amount"
+	
+	FlagDurationBlocks = "duration-blocks"
+	FlagExpirySeconds  = "expiry-seconds"
+	FlagDurationSecs   = "duration-seconds"
+	FlagLicensee       = "licensee"
+	FlagMaxRedemptions = "max-redemptions"
+	FlagSrcPort        = "src-port"
+	FlagSrcChannel     = "src-channel"
+	FlagDestHeight     = "dest-height"
 )
 
 var (
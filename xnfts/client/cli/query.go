@@ -1 +1,72 @@
 package cli
+
+import (
+	"fmt"
+	
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/spf13/cobra"
+	
+	"github.com/FreeFlixMedia/modules/xnfts/internal/types"
+)
+
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the xnfts module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	
+	cmd.AddCommand(
+		GetCmdQueryLicenseOffers(cdc),
+		GetCmdQueryMintVoucher(cdc),
+	)
+	
+	return cmd
+}
+
+func GetCmdQueryLicenseOffers(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "offers [primary-nft-id]",
+		Short: "Get license offers made for primary NFT",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, types.QueryLicenseOffers, args[0]), nil)
+			if err != nil {
+				return err
+			}
+			
+			var offers []types.LicenseOffer
+			cdc.MustUnmarshalJSON(res, &offers)
+			return cliCtx.PrintOutput(offers)
+		},
+	}
+	return flags.GetCommands(cmd)[0]
+}
+
+func GetCmdQueryMintVoucher(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint-voucher [creator] [nonce]",
+		Short: "Get the primary NFT a mint voucher was redeemed as",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s/%s", types.QuerierRoute, types.QueryMintVoucher, args[0], args[1]), nil)
+			if err != nil {
+				return err
+			}
+			
+			var redemption types.MintVoucherRedemption
+			cdc.MustUnmarshalJSON(res, &redemption)
+			return cliCtx.PrintOutput(redemption)
+		},
+	}
+	return flags.GetCommands(cmd)[0]
+}
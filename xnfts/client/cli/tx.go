@@ -2,7 +2,10 @@ package cli
 
 import (
 	"bufio"
+	"io/ioutil"
 	"strconv"
+	"strings"
+	"time"
 	
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -26,7 +29,27 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 	ics20XNFTTransferTxCmd.AddCommand(flags.PostCommands(
 		GetXNFTTxCmd(cdc),
 		GetMsgPayLicensingFee(cdc),
+		GetMsgSubscribeLicense(cdc),
+		GetMsgTopUpSubscription(cdc),
+		GetMsgCancelSubscription(cdc),
+		GetMsgMakeLicenseOffer(cdc),
+		GetMsgAcceptLicenseOffer(cdc),
+		GetMsgRejectLicenseOffer(cdc),
+		GetMsgRedeemLicenseOffer(cdc),
+		GetMsgRevokeSignedOffer(cdc),
+		GetMsgOfferSublicense(cdc),
+		GetMsgBuySublicense(cdc),
+		GetMsgTransferSecondaryNFT(cdc),
+		GetMsgXNFTBatchTransfer(cdc),
+		GetMsgPayBundleLicensingFee(cdc),
+		GetMsgRedeemMintVoucher(cdc),
 	)...)
+	ics20XNFTTransferTxCmd.AddCommand(
+		GetCmdSignLicenseOffer(cdc),
+		GetCmdInspectLicenseOffer(cdc),
+		GetCmdSignMintVoucher(cdc),
+		GetCmdInspectMintVoucher(cdc),
+	)
 	
 	return ics20XNFTTransferTxCmd
 }
@@ -82,6 +105,7 @@ func GetXNFTTxCmd(cdc *codec.Codec) *cobra.Command {
 				LicensingFee:  fee,
 				RevenueShare:  share,
 				TwitterHandle: handle,
+				Platform:      viper.GetString(FlagPlatform),
 			}
 			
 			msg := types.NewMsgXNFTTransfer(srcPort, srcChannel, uint64(destHeight), sender, data)
@@ -97,7 +121,8 @@ func GetXNFTTxCmd(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().String(FlagRevenueShare, "0", "Revenue share")
 	cmd.Flags().String(FlagLicensingFee, "0coco", "Licenese fee")
 	cmd.Flags().String(FlagAssetID, "", "AssetID")
-	cmd.Flags().String(FlagTwitterHandle, "", "Twitter Handle")
+	cmd.Flags().String(FlagTwitterHandle, "", "Handle of the author on the platform")
+	cmd.Flags().String(FlagPlatform, "", "Platform of the post, one of twitter, youtube, instagram or generic")
 	return cmd
 }
 
@@ -130,3 +155,564 @@ func GetMsgPayLicensingFee(cdc *codec.Codec) *cobra.Command {
 	}
 	return cmd
 }
+
+func GetMsgSubscribeLicense(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "subscribe-license [src-port] [src-channel] [dest-height] [amount] [deposit] [recipient] [primary-nft-id]",
+		Short: "Pay the first licensing fee from coco account and deposit the fees of the following subscription periods",
+		Args:  cobra.ExactArgs(7),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+			
+			fee, err := sdk.ParseCoin(args[3])
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoin(args[4])
+			if err != nil {
+				return err
+			}
+			destHeight, err := strconv.Atoi(args[2])
+			if err != nil {
+				return err
+			}
+			
+			msg := types.NewMsgSubscribeLicense(args[0], args[1], args[6], uint64(destHeight), fee, deposit, cliCtx.GetFromAddress(), args[5])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+func GetMsgTopUpSubscription(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "top-up-subscription [primary-nft-id] [amount]",
+		Short: "Add funds to the deposit of a license subscription",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+			
+			amount, err := sdk.ParseCoin(args[1])
+			if err != nil {
+				return err
+			}
+			
+			msg := types.NewMsgTopUpSubscription(cliCtx.GetFromAddress(), args[0], amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+func GetMsgCancelSubscription(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-subscription [primary-nft-id]",
+		Short: "Cancel a license subscription and refund the remaining deposit",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+			
+			msg := types.NewMsgCancelSubscription(cliCtx.GetFromAddress(), args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+func GetMsgMakeLicenseOffer(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "make-license-offer [src-port] [src-channel] [dest-height] [fee] [recipient] [primary-nft-id]",
+		Short: "Escrow a license offer on coco account for the primary owner to accept or reject",
+		Args:  cobra.ExactArgs(6),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+			
+			destHeight, err := strconv.Atoi(args[2])
+			if err != nil {
+				return err
+			}
+			fee, err := sdk.ParseCoin(args[3])
+			if err != nil {
+				return err
+			}
+			share, err := sdk.NewDecFromStr(viper.GetString(FlagRevenueShare))
+			if err != nil {
+				return err
+			}
+			
+			msg := types.NewMsgMakeLicenseOffer(args[0], args[1], uint64(destHeight), cliCtx.GetFromAddress(), args[4], args[5],
+				fee, share, viper.GetInt64(FlagDurationBlocks), viper.GetInt64(FlagExpirySeconds))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().String(FlagRevenueShare, "0", "revenue share offered to the primary owner")
+	cmd.Flags().Int64(FlagDurationBlocks, 0, "license duration in blocks, 0 keeps the terms of the primary nft")
+	cmd.Flags().Int64(FlagExpirySeconds, 86400, "seconds the offer stays open")
+	return cmd
+}
+
+func GetMsgAcceptLicenseOffer(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-license-offer [channel] [offer-id]",
+		Short: "Accept a license offer made for an owned primary nft",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+			
+			offerID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			
+			msg := types.NewMsgAcceptLicenseOffer(cliCtx.GetFromAddress(), args[0], offerID)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+func GetMsgRejectLicenseOffer(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reject-license-offer [channel] [offer-id]",
+		Short: "Reject a license offer made for an owned primary nft",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+			
+			offerID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			
+			msg := types.NewMsgRejectLicenseOffer(cliCtx.GetFromAddress(), args[0], offerID)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+func GetCmdSignLicenseOffer(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-license-offer [primary-nft-id] [fee] [nonce] [expiry-seconds]",
+		Short: "Sign a license offer for an owned primary nft that licensees can redeem",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf)
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+			
+			fee, err := sdk.ParseCoin(args[1])
+			if err != nil {
+				return err
+			}
+			nonce, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+			expirySeconds, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+			
+			terms := types.NewSignedOfferTerms(args[0], cliCtx.GetFromAddress().String(), viper.GetString(FlagLicensee), fee,
+				viper.GetInt64(FlagDurationBlocks), viper.GetInt64(FlagDurationSecs), viper.GetUint64(FlagMaxRedemptions),
+				nonce, time.Now().UTC().Add(time.Duration(expirySeconds)*time.Second), cliCtx.ChainID)
+			if err := terms.ValidateBasic(); err != nil {
+				return err
+			}
+			
+			signature, pubKey, err := txBldr.Keybase().Sign(cliCtx.FromName, terms.GetSignBytes())
+			if err != nil {
+				return err
+			}
+			
+			return cliCtx.PrintOutput(types.NewSignedLicenseOffer(terms, pubKey, signature))
+		},
+	}
+	cmd.Flags().String(FlagLicensee, "", "only licensee allowed to redeem the offer, anyone when empty")
+	cmd.Flags().Int64(FlagDurationBlocks, 0, "license duration in blocks")
+	cmd.Flags().Int64(FlagDurationSecs, 0, "license duration in seconds")
+	cmd.Flags().Uint64(FlagMaxRedemptions, 0, "number of times the offer can be redeemed, unlimited when 0")
+	return cmd
+}
+
+func GetCmdInspectLicenseOffer(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "inspect-license-offer [offer-file]",
+		Short: "Print the terms of a signed license offer and check its signature",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			
+			offer, err := readSignedLicenseOffer(cdc, args[0])
+			if err != nil {
+				return err
+			}
+			
+			return cliCtx.PrintOutput(types.NewSignedLicenseOfferInspection(offer, time.Now()))
+		},
+	}
+	return cmd
+}
+
+func GetMsgRedeemLicenseOffer(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redeem-license-offer [src-port] [src-channel] [dest-height] [offer-file]",
+		Short: "Pay the fee of a signed license offer from coco account",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+			
+			destHeight, err := strconv.Atoi(args[2])
+			if err != nil {
+				return err
+			}
+			offer, err := readSignedLicenseOffer(cdc, args[3])
+			if err != nil {
+				return err
+			}
+			
+			msg := types.NewMsgRedeemLicenseOffer(args[0], args[1], uint64(destHeight), cliCtx.GetFromAddress(), offer)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+func readSignedLicenseOffer(cdc *codec.Codec, file string) (types.SignedLicenseOffer, error) {
+	var offer types.SignedLicenseOffer
+	
+	bz, err := ioutil.ReadFile(file)
+	if err != nil {
+		return offer, err
+	}
+	
+	err = cdc.UnmarshalJSON(bz, &offer)
+	return offer, err
+}
+
+func GetMsgRevokeSignedOffer(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-signed-offer [nonce]",
+		Short: "Revoke the license offers signed with the nonce before they expire",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+			
+			nonce, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			
+			msg := types.NewMsgRevokeSignedOffer(cliCtx.GetFromAddress(), nonce)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+func GetMsgOfferSublicense(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "offer-sublicense [secondary-nft-id] [fee] [revenue-share]",
+		Short: "Open an owned secondary nft to sublicensing for the fee and a share of the sublicensee revenue",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+			
+			fee, err := sdk.ParseCoin(args[1])
+			if err != nil {
+				return err
+			}
+			share, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return err
+			}
+			
+			msg := types.NewMsgOfferSublicense(cliCtx.GetFromAddress(), args[0], fee, share)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+func GetMsgBuySublicense(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "buy-sublicense [parent-nft-id] [fee]",
+		Short: "Buy a sublicense of a secondary nft, pass --src-channel when it is held on another chain",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+			
+			fee, err := sdk.ParseCoin(args[1])
+			if err != nil {
+				return err
+			}
+			
+			msg := types.NewMsgBuySublicense(viper.GetString(FlagSrcPort), viper.GetString(FlagSrcChannel),
+				viper.GetUint64(FlagDestHeight), cliCtx.GetFromAddress(), args[0], fee)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().String(FlagSrcPort, types.PortID, "port of the channel to the chain holding the parent nft")
+	cmd.Flags().String(FlagSrcChannel, "", "channel to the chain holding the parent nft, empty when held on this chain")
+	cmd.Flags().Uint64(FlagDestHeight, 0, "destination height the packet times out from")
+	return cmd
+}
+
+func GetMsgTransferSecondaryNFT(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-secondary-nft [secondary-nft-id] [recipient]",
+		Short: "Transfer an owned secondary nft along with its license to the recipient",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+			
+			recipient, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+			
+			msg := types.NewMsgTransferSecondaryNFT(cliCtx.GetFromAddress(), args[0], recipient)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+func GetMsgXNFTBatchTransfer(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nft-batch-transfer [src-port] [src-channel] [dest-height] [recipient] [primary-nft-ids]",
+		Short: "License comma separated primary nfts to the recipient through a single IBC packet",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+			
+			destHeight, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+			
+			msg := types.NewMsgXNFTBatchTransfer(args[0], args[1], destHeight, cliCtx.GetFromAddress(),
+				strings.Split(args[4], ","), args[3])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+func GetMsgPayBundleLicensingFee(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pay-bundle-licensing-fee [src-port] [src-channel] [dest-height] [bundle-id] [fee]",
+		Short: "Pay the price of a bundle on the primary chain to license all of its nfts",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+			
+			destHeight, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+			
+			bundleID, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+			
+			fee, err := sdk.ParseCoin(args[4])
+			if err != nil {
+				return err
+			}
+			
+			msg := types.NewMsgPayBundleLicensingFee(args[0], args[1], destHeight, cliCtx.GetFromAddress(), bundleID, fee)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+func GetCmdSignMintVoucher(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-mint-voucher [terms-file] [nonce] [expiry-seconds]",
+		Short: "Sign a voucher for an unminted primary nft, the first license bought with it mints the nft",
+		Long: `Sign the mint voucher terms in the json file, the asset, platform, handle, license terms,
+licensing fee, revenue share, royalty rate, max licensees and metadata of the nft. The creator is the signer.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf)
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+			
+			bz, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var terms types.MintVoucherTerms
+			if err := cdc.UnmarshalJSON(bz, &terms); err != nil {
+				return err
+			}
+			nonce, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			expirySeconds, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+			
+			terms = types.NewMintVoucherTerms(cliCtx.GetFromAddress().String(), terms.AssetID, terms.Platform,
+				terms.TwitterHandle, terms.LicenseTerms, terms.LicensingFee, terms.RevenueShare, terms.RoyaltyRate,
+				terms.MaxLicensees, terms.Metadata, nonce, time.Now().UTC().Add(time.Duration(expirySeconds)*time.Second),
+				cliCtx.ChainID)
+			if err := terms.ValidateBasic(); err != nil {
+				return err
+			}
+			
+			signature, pubKey, err := txBldr.Keybase().Sign(cliCtx.FromName, terms.GetSignBytes())
+			if err != nil {
+				return err
+			}
+			
+			return cliCtx.PrintOutput(types.NewMintVoucher(terms, pubKey, signature))
+		},
+	}
+	return cmd
+}
+
+func GetCmdInspectMintVoucher(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "inspect-mint-voucher [voucher-file]",
+		Short: "Print the terms of a mint voucher and check its signature",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			
+			voucher, err := readMintVoucher(cdc, args[0])
+			if err != nil {
+				return err
+			}
+			
+			return cliCtx.PrintOutput(types.NewMintVoucherInspection(voucher, time.Now()))
+		},
+	}
+	return cmd
+}
+
+func GetMsgRedeemMintVoucher(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redeem-mint-voucher [src-port] [src-channel] [dest-height] [voucher-file]",
+		Short: "Pay the licensing fee of a mint voucher from coco account, minting the nft if it is not minted yet",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+			
+			destHeight, err := strconv.Atoi(args[2])
+			if err != nil {
+				return err
+			}
+			voucher, err := readMintVoucher(cdc, args[3])
+			if err != nil {
+				return err
+			}
+			
+			msg := types.NewMsgRedeemMintVoucher(args[0], args[1], uint64(destHeight), cliCtx.GetFromAddress(), voucher)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+func readMintVoucher(cdc *codec.Codec, file string) (types.MintVoucher, error) {
+	var voucher types.MintVoucher
+	
+	bz, err := ioutil.ReadFile(file)
+	if err != nil {
+		return voucher, err
+	}
+	
+	err = cdc.UnmarshalJSON(bz, &voucher)
+	return voucher, err
+}
@@ -5,6 +5,7 @@ import (
 	
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	
+	"github.com/FreeFlixMedia/modules/nfts"
 	"github.com/FreeFlixMedia/modules/xnfts/internal/types"
 )
 
@@ -15,11 +16,75 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, state types.GenesisState) {
 			panic(fmt.Sprintf("could not claim port capability: %v", err))
 		}
 	}
+	
+	for _, license := range state.ExpiringLicenses {
+		keeper.InsertLicenseExpiryQueue(ctx, license)
+	}
+	
+	for _, subscription := range state.Subscriptions {
+		keeper.SetSubscription(ctx, subscription)
+		if subscription.IsActive() {
+			keeper.InsertSubscriptionQueue(ctx, subscription)
+		}
+	}
+	
+	for _, offer := range state.LicenseOffers {
+		keeper.SetLicenseOffer(ctx, offer)
+		if offer.IsPending() {
+			keeper.InsertLicenseOfferQueue(ctx, offer)
+		}
+	}
+	keeper.SetLicenseOfferCount(ctx, state.LicenseOfferCount)
+	
+	for _, redemption := range state.SignedOfferRedemptions {
+		keeper.SetSignedOfferRedemptions(ctx, redemption.Owner, redemption.Nonce, redemption.Count)
+	}
+	
+	for _, revoked := range state.RevokedSignedOffers {
+		keeper.SetSignedOfferRevoked(ctx, revoked.Owner, revoked.Nonce)
+	}
+	
+	for _, redemption := range state.MintVoucherRedemptions {
+		keeper.SetMintVoucherNFTID(ctx, redemption.Creator, redemption.Nonce, redemption.PrimaryNFTID)
+	}
+	
+	for _, index := range state.SecondaryNFTIndexes {
+		keeper.SetSecondaryNFTIndex(ctx, index.PrimaryNFTID, index.SecondaryNFTID)
+	}
+	
+	// genesis exported before the index was introduced, the nfts module is initialized first
+	if len(state.SecondaryNFTIndexes) == 0 && nfts.GetContextOfCurrentChain() == nfts.CoCoContext {
+		keeper.IndexSecondaryNFTs(ctx)
+	}
+	
+	for _, notice := range state.LicenseExpiryNotices {
+		keeper.InsertLicenseExpiryNoticeQueue(ctx, notice)
+	}
+	
+	for _, license := range state.PendingLicenses {
+		keeper.SetPendingLicense(ctx, license.PrimaryNFTID, license.Channel, license.Sequence, license.Licensee)
+	}
+	
+	for _, grant := range state.PendingAuctionGrants {
+		keeper.SetPendingAuctionGrant(ctx, grant.Channel, grant.Sequence, grant.AuctionID)
+	}
 }
 func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 	portID := keeper.GetPort(ctx)
 	
 	return types.GenesisState{
-		PortID: portID,
+		PortID:            portID,
+		ExpiringLicenses:  keeper.GetAllExpiringLicenses(ctx),
+		Subscriptions:     keeper.GetAllSubscriptions(ctx),
+		LicenseOffers:     keeper.GetAllLicenseOffers(ctx),
+		LicenseOfferCount: keeper.GetLicenseOfferCount(ctx),
+		
+		SignedOfferRedemptions: keeper.GetAllSignedOfferRedemptions(ctx),
+		RevokedSignedOffers:    keeper.GetAllRevokedSignedOffers(ctx),
+		MintVoucherRedemptions: keeper.GetAllMintVoucherRedemptions(ctx),
+		SecondaryNFTIndexes:    keeper.GetAllSecondaryNFTIndexes(ctx),
+		LicenseExpiryNotices:   keeper.GetAllLicenseExpiryNotices(ctx),
+		PendingLicenses:        keeper.GetAllPendingLicenses(ctx),
+		PendingAuctionGrants:   keeper.GetAllPendingAuctionGrants(ctx),
 	}
 }
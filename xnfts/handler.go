@@ -2,6 +2,7 @@ package xnfts
 
 import (
 	"fmt"
+	"strings"
 	
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -20,6 +21,34 @@ func NewHandler(k Keeper) sdk.Handler {
 			return handleMsgXNFTTransfer(ctx, k, msg)
 		case MsgPayLicensingFee:
 			return handlePayLicensingFeeAndNFTTransfer(ctx, k, msg)
+		case MsgSubscribeLicense:
+			return handleMsgSubscribeLicense(ctx, k, msg)
+		case MsgTopUpSubscription:
+			return handleMsgTopUpSubscription(ctx, k, msg)
+		case MsgCancelSubscription:
+			return handleMsgCancelSubscription(ctx, k, msg)
+		case MsgMakeLicenseOffer:
+			return handleMsgMakeLicenseOffer(ctx, k, msg)
+		case MsgAcceptLicenseOffer:
+			return handleMsgAcceptLicenseOffer(ctx, k, msg)
+		case MsgRejectLicenseOffer:
+			return handleMsgRejectLicenseOffer(ctx, k, msg)
+		case MsgRedeemLicenseOffer:
+			return handleMsgRedeemLicenseOffer(ctx, k, msg)
+		case MsgRevokeSignedOffer:
+			return handleMsgRevokeSignedOffer(ctx, k, msg)
+		case MsgOfferSublicense:
+			return handleMsgOfferSublicense(ctx, k, msg)
+		case MsgBuySublicense:
+			return handleMsgBuySublicense(ctx, k, msg)
+		case MsgTransferSecondaryNFT:
+			return handleMsgTransferSecondaryNFT(ctx, k, msg)
+		case MsgXNFTBatchTransfer:
+			return handleMsgXNFTBatchTransfer(ctx, k, msg)
+		case MsgPayBundleLicensingFee:
+			return handleMsgPayBundleLicensingFee(ctx, k, msg)
+		case MsgRedeemMintVoucher:
+			return handleMsgRedeemMintVoucher(ctx, k, msg)
 		
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized ICS-20 xnft message type: %T", msg)
@@ -32,27 +61,11 @@ func handleMsgXNFTTransfer(ctx sdk.Context, k Keeper, msg MsgXNFTTransfer) (*sdk
 	var packet BaseNFTPacket
 	
 	if nfts.GetContextOfCurrentChain() == nfts.FreeFlixContext {
-		nft, found := k.GetTweetNFTByID(ctx, msg.PrimaryNFTID)
-		if !found {
-			return nil, sdkerrors.Wrap(nfts.ErrNFTNotFound, "")
+		_packet, err := k.UpdateSecondaryNFTOwner(ctx, msg)
+		if err != nil {
+			return nil, err
 		}
-		
-		if !nft.License {
-			return nil, sdkerrors.Wrap(nfts.ErrInvalidLicense, fmt.Sprintf("unable to transfer %s", nft.PrimaryNFTID))
-		}
-		
-		if !msg.Sender.Equals(types.GetHexAddressFromBech32String(nft.PrimaryOwner)) {
-			return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "")
-		}
-		
-		packet.PrimaryNFTID = nft.PrimaryNFTID
-		packet.PrimaryNFTOwner = nft.PrimaryOwner
-		packet.License = nft.License
-		packet.AssetID = nft.AssetID
-		packet.RevenueShare = nft.RevenueShare
-		packet.LicensingFee = nft.LicensingFee
-		packet.SecondaryNFTOwner = msg.Recipient
-		packet.TwitterHandle = nft.TwitterHandle
+		packet = _packet
 		
 	} else if nfts.GetContextOfCurrentChain() == nfts.CoCoContext {
 		
@@ -60,13 +73,15 @@ func handleMsgXNFTTransfer(ctx sdk.Context, k Keeper, msg MsgXNFTTransfer) (*sdk
 		sNFTID := nfts.GetSecondaryNFTID(count)
 		
 		packet.PrimaryNFTOwner = msg.Recipient
-		packet.License = true
+		terms := nfts.DefaultLicenseTerms()
+		packet.LicenseTerms = &terms
 		packet.AssetID = msg.AssetID
 		packet.RevenueShare = msg.RevenueShare
 		packet.LicensingFee = msg.LicensingFee
 		packet.SecondaryNFTID = sNFTID
 		packet.SecondaryNFTOwner = msg.Sender.String()
-		packet.TwitterHandle = msg.TwitterHandle
+		packet.Platform = nfts.NormalizePlatform(msg.Platform)
+		packet.TwitterHandle = nfts.NormalizeHandle(packet.Platform, msg.TwitterHandle)
 		
 		k.MintTweetNFT(ctx, *packet.ToBaseTweetNFT())
 		k.SetTweetIDToAccount(ctx, msg.Sender, sNFTID)
@@ -101,11 +116,17 @@ func handleXNFTRecvPacket(ctx sdk.Context, k Keeper, packet channeltypes.Packet)
 		Error:   "",
 	}
 	
-	if err := k.OnRecvNFTPacket(ctx, nftData, packet); err != nil {
+	cacheCtx, write := ctx.CacheContext()
+	nftData, err := k.OnRecvNFTPacket(cacheCtx, nftData, packet)
+	if err != nil {
 		acknowledgement = PostCreationPacketAcknowledgement{
 			Success: false,
 			Error:   err.Error(),
 		}
+	} else {
+		acknowledgement.SecondaryNFTID = nftData.SecondaryNFTID
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
 	}
 	
 	if err := k.PacketExecuted(ctx, packet, acknowledgement.GetBytes()); err != nil {
@@ -160,18 +181,29 @@ func handlePayLicensingFeeAndNFTTransferRecvPacket(ctx sdk.Context, k Keeper, pa
 		Error:   "",
 	}
 	
-	nft, found := k.GetTweetNFTByID(ctx, data.PrimaryNFTID)
-	if !found {
-		acknowledgement = PostCreationPacketAcknowledgement{
-			Success: false,
-			Error:   "nft not found",
+	if data.Voucher != nil {
+		cacheCtx, write := ctx.CacheContext()
+		primaryNFTID, err := k.OnRecvMintVoucher(cacheCtx, data, packet.DestinationChannel)
+		if err != nil {
+			acknowledgement = PostCreationPacketAcknowledgement{
+				Success: false,
+				Error:   err.Error(),
+			}
+		} else {
+			data.PrimaryNFTID = primaryNFTID
+			write()
+			ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
 		}
-	}
-	
-	if err := k.OnRecvXNFTTokenTransfer(ctx, data); err != nil {
-		acknowledgement = PostCreationPacketAcknowledgement{
-			Success: false,
-			Error:   err.Error(),
+	} else {
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.OnRecvXNFTTokenTransfer(cacheCtx, data, packet.DestinationChannel); err != nil {
+			acknowledgement = PostCreationPacketAcknowledgement{
+				Success: false,
+				Error:   err.Error(),
+			}
+		} else {
+			write()
+			ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
 		}
 	}
 	
@@ -187,6 +219,13 @@ func handlePayLicensingFeeAndNFTTransferRecvPacket(ctx sdk.Context, k Keeper, pa
 		),
 	)
 	
+	if !acknowledgement.Success {
+		return &sdk.Result{
+			Events: ctx.EventManager().Events().ToABCIEvents(),
+		}, nil
+	}
+	
+	nft, _ := k.GetTweetNFTByID(ctx, data.PrimaryNFTID)
 	input := NFTInput{
 		PrimaryNFTID:  data.PrimaryNFTID,
 		Recipient:     data.Sender,
@@ -194,15 +233,650 @@ func handlePayLicensingFeeAndNFTTransferRecvPacket(ctx sdk.Context, k Keeper, pa
 		LicensingFee:  data.LicensingFee,
 		RevenueShare:  nft.RevenueShare,
 		TwitterHandle: nft.TwitterHandle,
+		Platform:      nft.Platform,
 	}
 	
 	msg := NewMsgXNFTTransfer(packet.DestinationPort, packet.DestinationChannel, packet.GetTimeoutHeight(),
 		GetHexAddressFromBech32String(data.Recipient), input)
 	
-	if err := k.XNFTTransfer(ctx, msg); err != nil {
+	if data.SignedOffer != nil {
+		err := k.XNFTTransferOnOfferedTerms(ctx, msg, data.SignedOffer.Terms.DurationBlocks, data.SignedOffer.Terms.DurationSeconds)
+		if err != nil {
+			return nil, err
+		}
+	} else if err := k.XNFTTransfer(ctx, msg); err != nil {
+		return nil, err
+	}
+	
+	return &sdk.Result{
+		Events: ctx.EventManager().Events().ToABCIEvents(),
+	}, nil
+}
+
+func handleLicenseExpiredRecvPacket(ctx sdk.Context, k Keeper, packet channeltypes.Packet, data PacketLicenseExpired) (*sdk.Result, error) {
+	acknowledgement := PostCreationPacketAcknowledgement{
+		Success:        true,
+		Error:          "",
+		SecondaryNFTID: data.SecondaryNFTID,
+	}
+	
+	if err := k.OnRecvLicenseExpired(ctx, data, packet); err != nil {
+		acknowledgement = PostCreationPacketAcknowledgement{
+			Success: false,
+			Error:   err.Error(),
+		}
+	}
+	
+	if err := k.PacketExecuted(ctx, packet, acknowledgement.GetBytes()); err != nil {
+		return nil, err
+	}
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeLicenseExpired,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyPrimaryNFTID, data.PrimaryNFTID),
+			sdk.NewAttribute(types.AttributeKeySecondaryNFTID, data.SecondaryNFTID),
+		),
+	)
+	
+	return &sdk.Result{
+		Events: ctx.EventManager().Events().ToABCIEvents(),
+	}, nil
+}
+
+func handleMsgSubscribeLicense(ctx sdk.Context, k Keeper, msg MsgSubscribeLicense) (*sdk.Result, error) {
+	packet, err := k.SubscribeLicense(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.XTransfer(ctx, msg.SrcPort, msg.SrcChannel, msg.DestHeight, packet.GetBytes()); err != nil {
+		return nil, err
+	}
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeSubscribeLicense,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+			sdk.NewAttribute(types.AttributeKeyReceiver, msg.Recipient),
+			sdk.NewAttribute(types.AttributeKeyPrimaryNFTID, msg.PrimaryNFTID),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.LicensingFee.String()),
+		),
+	)
+	
+	return &sdk.Result{
+		Events: ctx.EventManager().Events().ToABCIEvents(),
+	}, nil
+}
+
+func handleMsgTopUpSubscription(ctx sdk.Context, k Keeper, msg MsgTopUpSubscription) (*sdk.Result, error) {
+	if err := k.TopUpSubscription(ctx, msg); err != nil {
+		return nil, err
+	}
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeTopUpSubscription,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+			sdk.NewAttribute(types.AttributeKeyPrimaryNFTID, msg.PrimaryNFTID),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+		),
+	)
+	
+	return &sdk.Result{
+		Events: ctx.EventManager().Events().ToABCIEvents(),
+	}, nil
+}
+
+func handleMsgCancelSubscription(ctx sdk.Context, k Keeper, msg MsgCancelSubscription) (*sdk.Result, error) {
+	if err := k.CancelSubscription(ctx, msg); err != nil {
+		return nil, err
+	}
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeCancelSubscription,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+			sdk.NewAttribute(types.AttributeKeyPrimaryNFTID, msg.PrimaryNFTID),
+		),
+	)
+	
+	return &sdk.Result{
+		Events: ctx.EventManager().Events().ToABCIEvents(),
+	}, nil
+}
+
+func handleSubscriptionPaymentRecvPacket(ctx sdk.Context, k Keeper, packet channeltypes.Packet, data PacketSubscriptionPayment) (*sdk.Result, error) {
+	acknowledgement := PostCreationPacketAcknowledgement{
+		Success:        true,
+		Error:          "",
+		SecondaryNFTID: data.SecondaryNFTID,
+	}
+	
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.OnRecvSubscriptionPayment(cacheCtx, data, packet); err != nil {
+		acknowledgement = PostCreationPacketAcknowledgement{
+			Success: false,
+			Error:   err.Error(),
+		}
+	} else {
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+	
+	if err := k.PacketExecuted(ctx, packet, acknowledgement.GetBytes()); err != nil {
+		return nil, err
+	}
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeSubscriptionPayment,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyPrimaryNFTID, data.PrimaryNFTID),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, data.Fee.String()),
+		),
+	)
+	
+	return &sdk.Result{
+		Events: ctx.EventManager().Events().ToABCIEvents(),
+	}, nil
+}
+
+func handleMsgMakeLicenseOffer(ctx sdk.Context, k Keeper, msg MsgMakeLicenseOffer) (*sdk.Result, error) {
+	offer, err := k.MakeLicenseOffer(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	
+	packet := types.NewPacketLicenseOffer(offer)
+	if err := k.XTransfer(ctx, msg.SrcPort, msg.SrcChannel, msg.DestHeight, packet.GetBytes()); err != nil {
+		return nil, err
+	}
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeMakeLicenseOffer,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+			sdk.NewAttribute(types.AttributeKeyReceiver, msg.Recipient),
+			sdk.NewAttribute(types.AttributeKeyPrimaryNFTID, msg.PrimaryNFTID),
+			sdk.NewAttribute(types.AttributeKeyOfferID, fmt.Sprintf("%d", offer.ID)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Fee.String()),
+		),
+	)
+	
+	return &sdk.Result{
+		Events: ctx.EventManager().Events().ToABCIEvents(),
+	}, nil
+}
+
+func handleMsgAcceptLicenseOffer(ctx sdk.Context, k Keeper, msg MsgAcceptLicenseOffer) (*sdk.Result, error) {
+	offer, err := k.AcceptLicenseOffer(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeAcceptLicenseOffer,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+			sdk.NewAttribute(types.AttributeKeyPrimaryNFTID, offer.PrimaryNFTID),
+			sdk.NewAttribute(types.AttributeKeyOfferID, fmt.Sprintf("%d", offer.ID)),
+		),
+	)
+	
+	return &sdk.Result{
+		Events: ctx.EventManager().Events().ToABCIEvents(),
+	}, nil
+}
+
+func handleMsgRejectLicenseOffer(ctx sdk.Context, k Keeper, msg MsgRejectLicenseOffer) (*sdk.Result, error) {
+	offer, err := k.RejectLicenseOffer(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeRejectLicenseOffer,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+			sdk.NewAttribute(types.AttributeKeyPrimaryNFTID, offer.PrimaryNFTID),
+			sdk.NewAttribute(types.AttributeKeyOfferID, fmt.Sprintf("%d", offer.ID)),
+		),
+	)
+	
+	return &sdk.Result{
+		Events: ctx.EventManager().Events().ToABCIEvents(),
+	}, nil
+}
+
+func handleLicenseOfferRecvPacket(ctx sdk.Context, k Keeper, packet channeltypes.Packet, data PacketLicenseOffer) (*sdk.Result, error) {
+	acknowledgement := PostCreationPacketAcknowledgement{
+		Success: true,
+		Error:   "",
+	}
+	
+	if err := k.OnRecvLicenseOffer(ctx, data, packet); err != nil {
+		acknowledgement = PostCreationPacketAcknowledgement{
+			Success: false,
+			Error:   err.Error(),
+		}
+	}
+	
+	if err := k.PacketExecuted(ctx, packet, acknowledgement.GetBytes()); err != nil {
 		return nil, err
 	}
 	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeLicenseOfferReceived,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyReceiver, data.Recipient),
+			sdk.NewAttribute(types.AttributeKeyPrimaryNFTID, data.PrimaryNFTID),
+			sdk.NewAttribute(types.AttributeKeyOfferID, fmt.Sprintf("%d", data.OfferID)),
+		),
+	)
+	
+	return &sdk.Result{
+		Events: ctx.EventManager().Events().ToABCIEvents(),
+	}, nil
+}
+
+func handleLicenseOfferResponseRecvPacket(ctx sdk.Context, k Keeper, packet channeltypes.Packet, data PacketLicenseOfferResponse) (*sdk.Result, error) {
+	acknowledgement := PostCreationPacketAcknowledgement{
+		Success: true,
+		Error:   "",
+	}
+	
+	if err := k.OnRecvLicenseOfferResponse(ctx, data, packet); err != nil {
+		acknowledgement = PostCreationPacketAcknowledgement{
+			Success: false,
+			Error:   err.Error(),
+		}
+	}
+	
+	if err := k.PacketExecuted(ctx, packet, acknowledgement.GetBytes()); err != nil {
+		return nil, err
+	}
+	
+	status := OfferStatusRejected
+	if data.Refund {
+		status = OfferStatusRefunded
+	} else if data.Accepted {
+		status = OfferStatusAccepted
+	}
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeLicenseOfferResponse,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyPrimaryNFTID, data.PrimaryNFTID),
+			sdk.NewAttribute(types.AttributeKeyOfferID, fmt.Sprintf("%d", data.OfferID)),
+			sdk.NewAttribute(types.AttributeKeyOfferStatus, status),
+		),
+	)
+	
+	return &sdk.Result{
+		Events: ctx.EventManager().Events().ToABCIEvents(),
+	}, nil
+}
+
+func handleMsgRedeemLicenseOffer(ctx sdk.Context, k Keeper, msg MsgRedeemLicenseOffer) (*sdk.Result, error) {
+	packet, err := k.RedeemLicenseOffer(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.XTransfer(ctx, msg.SrcPort, msg.SrcChannel, msg.DestHeight, packet.GetBytes()); err != nil {
+		return nil, err
+	}
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeRedeemLicenseOffer,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+			sdk.NewAttribute(types.AttributeKeyReceiver, msg.Offer.Terms.Owner),
+			sdk.NewAttribute(types.AttributeKeyPrimaryNFTID, msg.Offer.Terms.PrimaryNFTID),
+			sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprintf("%d", msg.Offer.Terms.Nonce)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Offer.Terms.Fee.String()),
+		),
+	)
+	
+	return &sdk.Result{
+		Events: ctx.EventManager().Events().ToABCIEvents(),
+	}, nil
+}
+
+func handleMsgRevokeSignedOffer(ctx sdk.Context, k Keeper, msg MsgRevokeSignedOffer) (*sdk.Result, error) {
+	if err := k.RevokeSignedOffer(ctx, msg); err != nil {
+		return nil, err
+	}
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeRevokeSignedOffer,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+			sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprintf("%d", msg.Nonce)),
+		),
+	)
+	
+	return &sdk.Result{
+		Events: ctx.EventManager().Events().ToABCIEvents(),
+	}, nil
+}
+
+func handleMsgOfferSublicense(ctx sdk.Context, k Keeper, msg MsgOfferSublicense) (*sdk.Result, error) {
+	nft, err := k.OfferSublicense(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeOfferSublicense,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+			sdk.NewAttribute(types.AttributeKeyPrimaryNFTID, nft.PrimaryNFTID),
+			sdk.NewAttribute(types.AttributeKeySecondaryNFTID, nft.SecondaryNFTID),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.LicensingFee.String()),
+		),
+	)
+	
+	return &sdk.Result{
+		Events: ctx.EventManager().Events().ToABCIEvents(),
+	}, nil
+}
+
+func handleMsgBuySublicense(ctx sdk.Context, k Keeper, msg MsgBuySublicense) (*sdk.Result, error) {
+	secondaryNFTID := ""
+	if msg.SrcChannel == "" {
+		sublicense, err := k.BuySublicense(ctx, msg.Sender, msg.ParentNFTID, msg.LicensingFee)
+		if err != nil {
+			return nil, err
+		}
+		secondaryNFTID = sublicense.SecondaryNFTID
+	} else {
+		packet, err := k.PayForSublicense(ctx, msg)
+		if err != nil {
+			return nil, err
+		}
+		if err := k.XTransfer(ctx, msg.SrcPort, msg.SrcChannel, msg.DestHeight, packet.GetBytes()); err != nil {
+			return nil, err
+		}
+	}
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeBuySublicense,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+			sdk.NewAttribute(types.AttributeKeyParentNFTID, msg.ParentNFTID),
+			sdk.NewAttribute(types.AttributeKeySecondaryNFTID, secondaryNFTID),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.LicensingFee.String()),
+		),
+	)
+	
+	return &sdk.Result{
+		Events: ctx.EventManager().Events().ToABCIEvents(),
+	}, nil
+}
+
+func handleBuySublicenseRecvPacket(ctx sdk.Context, k Keeper, packet channeltypes.Packet, data PacketBuySublicense) (*sdk.Result, error) {
+	acknowledgement := PostCreationPacketAcknowledgement{
+		Success: true,
+		Error:   "",
+	}
+	
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.OnRecvBuySublicense(cacheCtx, data, packet); err != nil {
+		acknowledgement = PostCreationPacketAcknowledgement{
+			Success: false,
+			Error:   err.Error(),
+		}
+	} else {
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+	
+	if err := k.PacketExecuted(ctx, packet, acknowledgement.GetBytes()); err != nil {
+		return nil, err
+	}
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeBuySublicense,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyParentNFTID, data.ParentNFTID),
+			sdk.NewAttribute(types.AttributeKeyReceiver, data.Sender),
+		),
+	)
+	
+	return &sdk.Result{
+		Events: ctx.EventManager().Events().ToABCIEvents(),
+	}, nil
+}
+
+func handleSublicenseRevenueRecvPacket(ctx sdk.Context, k Keeper, packet channeltypes.Packet, data PacketSublicenseRevenue) (*sdk.Result, error) {
+	acknowledgement := PostCreationPacketAcknowledgement{
+		Success: true,
+		Error:   "",
+	}
+	
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.OnRecvSublicenseRevenue(cacheCtx, data); err != nil {
+		acknowledgement = PostCreationPacketAcknowledgement{
+			Success: false,
+			Error:   err.Error(),
+		}
+	} else {
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+	
+	if err := k.PacketExecuted(ctx, packet, acknowledgement.GetBytes()); err != nil {
+		return nil, err
+	}
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeSublicenseRevenue,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeySecondaryNFTID, data.NFTID),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, data.Amount.String()),
+		),
+	)
+	
+	return &sdk.Result{
+		Events: ctx.EventManager().Events().ToABCIEvents(),
+	}, nil
+}
+
+func handleMsgTransferSecondaryNFT(ctx sdk.Context, k Keeper, msg MsgTransferSecondaryNFT) (*sdk.Result, error) {
+	nft, err := k.TransferSecondaryNFT(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeTransferSecondaryNFT,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+			sdk.NewAttribute(types.AttributeKeyReceiver, msg.Recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyPrimaryNFTID, nft.PrimaryNFTID),
+			sdk.NewAttribute(types.AttributeKeySecondaryNFTID, nft.SecondaryNFTID),
+		),
+	)
+	
+	return &sdk.Result{
+		Events: ctx.EventManager().Events().ToABCIEvents(),
+	}, nil
+}
+
+func handleLicenseTransferredRecvPacket(ctx sdk.Context, k Keeper, packet channeltypes.Packet, data PacketLicenseTransferred) (*sdk.Result, error) {
+	acknowledgement := PostCreationPacketAcknowledgement{
+		Success:        true,
+		Error:          "",
+		SecondaryNFTID: data.SecondaryNFTID,
+	}
+	
+	if err := k.OnRecvLicenseTransferred(ctx, data, packet); err != nil {
+		acknowledgement = PostCreationPacketAcknowledgement{
+			Success: false,
+			Error:   err.Error(),
+		}
+	}
+	
+	if err := k.PacketExecuted(ctx, packet, acknowledgement.GetBytes()); err != nil {
+		return nil, err
+	}
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeLicenseTransferred,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyPrimaryNFTID, data.PrimaryNFTID),
+			sdk.NewAttribute(types.AttributeKeySecondaryNFTID, data.SecondaryNFTID),
+			sdk.NewAttribute(types.AttributeKeyReceiver, data.Recipient),
+		),
+	)
+	
+	return &sdk.Result{
+		Events: ctx.EventManager().Events().ToABCIEvents(),
+	}, nil
+}
+
+func handleMsgXNFTBatchTransfer(ctx sdk.Context, k Keeper, msg MsgXNFTBatchTransfer) (*sdk.Result, error) {
+	packet, err := k.BatchNFTTransfer(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.XTransfer(ctx, msg.SourcePort, msg.SourceChannel, msg.DestHeight, packet.GetBytes()); err != nil {
+		return nil, err
+	}
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeNFTBatchTransfer,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+			sdk.NewAttribute(types.AttributeKeyReceiver, msg.Recipient),
+			sdk.NewAttribute(types.AttributeKeyPrimaryNFTID, strings.Join(msg.PrimaryNFTIDs, ",")),
+		),
+	)
+	
+	return &sdk.Result{
+		Events: ctx.EventManager().Events().ToABCIEvents(),
+	}, nil
+}
+
+func handleBatchNFTTransferRecvPacket(ctx sdk.Context, k Keeper, packet channeltypes.Packet, data PacketBatchNFTTransfer) (*sdk.Result, error) {
+	acknowledgement := PostCreationPacketAcknowledgement{
+		Success: true,
+		Error:   "",
+	}
+	
+	cacheCtx, write := ctx.CacheContext()
+	secondaryNFTIDs, err := k.OnRecvBatchNFTTransfer(cacheCtx, data, packet)
+	if err != nil {
+		acknowledgement = PostCreationPacketAcknowledgement{
+			Success: false,
+			Error:   err.Error(),
+		}
+	} else {
+		acknowledgement.SecondaryNFTIDs = secondaryNFTIDs
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+	
+	if err := k.PacketExecuted(ctx, packet, acknowledgement.GetBytes()); err != nil {
+		return nil, err
+	}
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeNFTBatchTransfer,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeySecondaryNFTID, strings.Join(secondaryNFTIDs, ",")),
+		),
+	)
+	
+	return &sdk.Result{
+		Events: ctx.EventManager().Events().ToABCIEvents(),
+	}, nil
+}
+
+func handleMsgPayBundleLicensingFee(ctx sdk.Context, k Keeper, msg MsgPayBundleLicensingFee) (*sdk.Result, error) {
+	packet, err := k.PayBundleLicensingFee(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.XTransfer(ctx, msg.SrcPort, msg.SrcChannel, msg.DestHeight, packet.GetBytes()); err != nil {
+		return nil, err
+	}
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypePayBundleLicensingFee,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+			sdk.NewAttribute(types.AttributeKeyBundleID, fmt.Sprintf("%d", msg.BundleID)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.LicensingFee.String()),
+		),
+	)
+	
+	return &sdk.Result{
+		Events: ctx.EventManager().Events().ToABCIEvents(),
+	}, nil
+}
+
+func handlePayBundleLicensingFeeRecvPacket(ctx sdk.Context, k Keeper, packet channeltypes.Packet, data PacketPayBundleLicensingFee) (*sdk.Result, error) {
+	acknowledgement := PostCreationPacketAcknowledgement{
+		Success: true,
+		Error:   "",
+	}
+	
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.OnRecvBundleLicensingFee(cacheCtx, data, packet); err != nil {
+		acknowledgement = PostCreationPacketAcknowledgement{
+			Success: false,
+			Error:   err.Error(),
+		}
+	} else {
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+	
+	if err := k.PacketExecuted(ctx, packet, acknowledgement.GetBytes()); err != nil {
+		return nil, err
+	}
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypePayBundleLicensingFee,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyBundleID, fmt.Sprintf("%d", data.BundleID)),
+			sdk.NewAttribute(types.AttributeKeyReceiver, data.Sender),
+		),
+	)
+	
+	return &sdk.Result{
+		Events: ctx.EventManager().Events().ToABCIEvents(),
+	}, nil
+}
+
+func handleMsgRedeemMintVoucher(ctx sdk.Context, k Keeper, msg MsgRedeemMintVoucher) (*sdk.Result, error) {
+	packet, err := k.RedeemMintVoucher(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.XTransfer(ctx, msg.SrcPort, msg.SrcChannel, msg.DestHeight, packet.GetBytes()); err != nil {
+		return nil, err
+	}
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeRedeemMintVoucher,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+			sdk.NewAttribute(types.AttributeKeyReceiver, msg.Voucher.Terms.Creator),
+			sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprintf("%d", msg.Voucher.Terms.Nonce)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Voucher.Terms.LicensingFee.String()),
+		),
+	)
+	
 	return &sdk.Result{
 		Events: ctx.EventManager().Events().ToABCIEvents(),
 	}, nil
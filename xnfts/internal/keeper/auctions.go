@@ -0,0 +1,194 @@
+package keeper
+
+import (
+	"fmt"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	
+	"github.com/FreeFlixMedia/modules/nfts"
+	"github.com/FreeFlixMedia/modules/xnfts/internal/types"
+)
+
+// SetPendingAuctionGrant links a license packet to the auction it settles, the winning bid stays
+// escrowed until the packet is acknowledged.
+func (k Keeper) SetPendingAuctionGrant(ctx sdk.Context, channel string, sequence, auctionID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPendingAuctionGrantKey(channel, sequence), k.cdc.MustMarshalBinaryLengthPrefixed(auctionID))
+}
+
+func (k Keeper) GetPendingAuctionGrant(ctx sdk.Context, channel string, sequence uint64) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	
+	bz := store.Get(types.GetPendingAuctionGrantKey(channel, sequence))
+	if bz == nil {
+		return 0, false
+	}
+	
+	var auctionID uint64
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &auctionID)
+	return auctionID, true
+}
+
+func (k Keeper) DeletePendingAuctionGrant(ctx sdk.Context, channel string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPendingAuctionGrantKey(channel, sequence))
+}
+
+func (k Keeper) GetAllPendingAuctionGrants(ctx sdk.Context) []types.PendingAuctionGrant {
+	store := ctx.KVStore(k.storeKey)
+	
+	iterator := sdk.KVStorePrefixIterator(store, types.PendingAuctionGrantPrefix)
+	defer iterator.Close()
+	
+	var grants []types.PendingAuctionGrant
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()[len(types.PendingAuctionGrantPrefix):]
+		
+		var auctionID uint64
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &auctionID)
+		grants = append(grants, types.PendingAuctionGrant{
+			Channel:   string(key[:len(key)-9]),
+			Sequence:  sdk.BigEndianToUint64(key[len(key)-8:]),
+			AuctionID: auctionID,
+		})
+	}
+	
+	return grants
+}
+
+// GrantAuctionedLicense sends the exclusive license won in an auction to the licensee chain.
+func (k Keeper) GrantAuctionedLicense(ctx sdk.Context, auction nfts.Auction) error {
+	nft, found := k.GetTweetNFTByID(ctx, auction.PrimaryNFTID)
+	if !found {
+		return sdkerrors.Wrap(nfts.ErrNFTNotFound, auction.PrimaryNFTID)
+	}
+	
+	if nft.PrimaryOwner != auction.Seller {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s changed hands during the auction", nft.PrimaryNFTID)
+	} else if !nft.IsLicensable() {
+		return sdkerrors.Wrapf(nfts.ErrInvalidLicense, "%s is not licensable", nft.PrimaryNFTID)
+	}
+	
+	terms := *nft.LicenseTerms
+	terms.Exclusive = true
+	nft.LicenseTerms = &terms
+	if err := k.ValidateLicenseCap(ctx, nft); err != nil {
+		return err
+	}
+	
+	owner, err := sdk.AccAddressFromBech32(auction.Seller)
+	if err != nil {
+		return err
+	}
+	
+	port := k.GetPort(ctx)
+	sequence, found := k.channelKeeper.GetNextSequenceSend(ctx, port, auction.Channel)
+	if !found {
+		return channeltypes.ErrSequenceSendNotFound
+	}
+	
+	msg := types.NewMsgXNFTTransfer(port, auction.Channel, 0, owner, types.NFTInput{
+		PrimaryNFTID: auction.PrimaryNFTID,
+		Recipient:    auction.Licensee,
+		LicensingFee: auction.HighestBid,
+		RevenueShare: nft.RevenueShare,
+	})
+	
+	packet, err := k.UpdateSecondaryNFTOwner(ctx, msg)
+	if err != nil {
+		return err
+	}
+	
+	packet.LicensingFee = auction.HighestBid
+	packet.LicenseTerms = &terms
+	if err := k.XTimedTransfer(ctx, port, auction.Channel, packet.GetBytes()); err != nil {
+		return err
+	}
+	
+	k.SetPendingAuctionGrant(ctx, auction.Channel, sequence, auction.ID)
+	return nil
+}
+
+// SettleLicenseAuctions grants the licenses of the license auctions that have ended, the auctions
+// without bids or whose license can not be granted are closed and the winning bid is refunded.
+func (k Keeper) SettleLicenseAuctions(ctx sdk.Context) {
+	for _, auction := range k.nftKeeper.DequeueEndedAuctions(ctx, nfts.AuctionKindLicense) {
+		if auction.HasBid() {
+			cacheCtx, write := ctx.CacheContext()
+			err := k.GrantAuctionedLicense(cacheCtx, auction)
+			if err == nil {
+				write()
+				ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+				
+				auction.Status = nfts.AuctionStatusSettling
+				k.nftKeeper.SetAuction(ctx, auction)
+				continue
+			}
+			k.Logger(ctx).Error(fmt.Sprintf("failed to grant license of auction %d: %s", auction.ID, err.Error()))
+		}
+		
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.nftKeeper.CloseAuction(cacheCtx, auction); err != nil {
+			// the auction stays queued and is closed again in the next block
+			k.Logger(ctx).Error(fmt.Sprintf("failed to close auction %d: %s", auction.ID, err.Error()))
+			k.nftKeeper.InsertAuctionQueue(ctx, auction)
+			continue
+		}
+		write()
+	}
+}
+
+// OnAcknowledgementAuctionGrant pays the seller once the auctioned license is granted on the
+// licensee chain, the winning bid is refunded if the grant failed.
+func (k Keeper) OnAcknowledgementAuctionGrant(ctx sdk.Context, ack types.PostCreationPacketAcknowledgement,
+	packet channeltypes.Packet) error {
+	auctionID, found := k.GetPendingAuctionGrant(ctx, packet.SourceChannel, packet.Sequence)
+	if !found {
+		return nil
+	}
+	
+	k.DeletePendingAuctionGrant(ctx, packet.SourceChannel, packet.Sequence)
+	auction, found := k.nftKeeper.GetAuction(ctx, auctionID)
+	if !found {
+		return nil
+	}
+	
+	if !ack.Success {
+		return k.nftKeeper.CloseAuction(ctx, auction)
+	}
+	
+	if _, err := k.nftKeeper.SettleAuction(ctx, auction); err != nil {
+		return err
+	}
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			nfts.EventTypeAuctionSettled,
+			sdk.NewAttribute(nfts.AttributePrimaryNFTID, auction.PrimaryNFTID),
+			sdk.NewAttribute(nfts.AttributeAuctionID, fmt.Sprintf("%d", auction.ID)),
+			sdk.NewAttribute(nfts.AttributeAuctionKind, auction.Kind),
+			sdk.NewAttribute(nfts.AttributeBuyer, auction.HighestBidder),
+			sdk.NewAttribute(nfts.AttributeBid, auction.HighestBid.String()),
+			sdk.NewAttribute(nfts.AttributeLicensee, auction.Licensee),
+			sdk.NewAttribute(nfts.AttributeSecondaryNFTID, ack.SecondaryNFTID),
+		),
+	)
+	return nil
+}
+
+func (k Keeper) OnTimeoutAuctionGrant(ctx sdk.Context, packet channeltypes.Packet) error {
+	auctionID, found := k.GetPendingAuctionGrant(ctx, packet.SourceChannel, packet.Sequence)
+	if !found {
+		return nil
+	}
+	
+	k.DeletePendingAuctionGrant(ctx, packet.SourceChannel, packet.Sequence)
+	auction, found := k.nftKeeper.GetAuction(ctx, auctionID)
+	if !found {
+		return nil
+	}
+	
+	return k.nftKeeper.CloseAuction(ctx, auction)
+}
@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	
+	"github.com/FreeFlixMedia/modules/nfts"
+	"github.com/FreeFlixMedia/modules/xnfts/internal/types"
+)
+
+// BatchNFTTransfer prepares the license of every nft of the batch, the batch is only sent when
+// all of them can be licensed to the recipient.
+func (k Keeper) BatchNFTTransfer(ctx sdk.Context, msg types.MsgXNFTBatchTransfer) (types.PacketBatchNFTTransfer, error) {
+	if nfts.GetContextOfCurrentChain() != nfts.FreeFlixContext {
+		return types.PacketBatchNFTTransfer{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "only primary nfts can be licensed in batches")
+	}
+	
+	packets := make([]types.BaseNFTPacket, 0, len(msg.PrimaryNFTIDs))
+	for _, primaryNFTID := range msg.PrimaryNFTIDs {
+		packet, err := k.UpdateSecondaryNFTOwner(ctx, types.NewMsgXNFTTransfer(msg.SourcePort, msg.SourceChannel,
+			msg.DestHeight, msg.Sender, types.NFTInput{PrimaryNFTID: primaryNFTID, Recipient: msg.Recipient}))
+		if err != nil {
+			return types.PacketBatchNFTTransfer{}, sdkerrors.Wrap(err, primaryNFTID)
+		}
+		packets = append(packets, packet)
+	}
+	
+	return types.NewPacketBatchNFTTransfer(packets), nil
+}
+
+// OnRecvBatchNFTTransfer mints the secondary nfts of the batch and returns their ids in the
+// order of the batch, the caller discards every nft of the batch when one of them fails.
+func (k Keeper) OnRecvBatchNFTTransfer(ctx sdk.Context, data types.PacketBatchNFTTransfer, packet channeltypes.Packet) ([]string, error) {
+	if err := data.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	
+	if nfts.GetContextOfCurrentChain() != nfts.CoCoContext {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "batches can only be licensed to the licensee chain")
+	}
+	
+	secondaryNFTIDs := make([]string, 0, len(data.NFTs))
+	for _, nft := range data.NFTs {
+		if nft.SecondaryNFTID != "" {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s already has a secondary nft", nft.PrimaryNFTID)
+		}
+		
+		minted, err := k.OnRecvNFTPacket(ctx, nft, packet)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, nft.PrimaryNFTID)
+		}
+		secondaryNFTIDs = append(secondaryNFTIDs, minted.SecondaryNFTID)
+	}
+	
+	return secondaryNFTIDs, nil
+}
+
+// OnAcknowledgementBatchNFTTransfer records the license grants of the batch once the secondary
+// nfts are minted.
+func (k Keeper) OnAcknowledgementBatchNFTTransfer(ctx sdk.Context, data types.PacketBatchNFTTransfer,
+	ack types.PostCreationPacketAcknowledgement, packet channeltypes.Packet) {
+	for i, nft := range data.NFTs {
+		nftAck := types.PostCreationPacketAcknowledgement{
+			Success: ack.Success,
+			Error:   ack.Error,
+		}
+		if ack.Success && i < len(ack.SecondaryNFTIDs) {
+			nftAck.SecondaryNFTID = ack.SecondaryNFTIDs[i]
+		}
+		
+		k.OnAcknowledgementNFTPacket(ctx, nft, nftAck, packet)
+	}
+}
+
+func (k Keeper) OnTimeoutBatchNFTTransfer(ctx sdk.Context, data types.PacketBatchNFTTransfer, packet channeltypes.Packet) {
+	for _, nft := range data.NFTs {
+		k.OnTimeoutNFTPacket(ctx, nft, packet)
+	}
+}
@@ -0,0 +1,60 @@
+package keeper_test
+
+import (
+	"testing"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	
+	"github.com/FreeFlixMedia/modules/internal/testutil"
+	"github.com/FreeFlixMedia/modules/nfts"
+	"github.com/FreeFlixMedia/modules/xnfts/internal/types"
+)
+
+func TestBatchNFTTransferRefusesUnlicensableNFT(t *testing.T) {
+	ctx, k, nftKeeper, _ := setupKeeper(t, nfts.FreeFlixContext)
+	
+	owner, licensee := testutil.NewAddr(), testutil.NewAddr()
+	licensable := mintNFT(t, ctx, nftKeeper, owner)
+	msg := nfts.NewMsgMintNFT(owner, "", testutil.NewAddr().String(), nil, testutil.Coin(100), sdk.NewDecWithPrec(1, 1),
+		0, 0, sdk.ZeroDec(), nil, nfts.PlatformTwitter, "creator", nfts.Metadata{})
+	royaltyRate, err := nftKeeper.ValidateMint(ctx, msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	unlicensable := nftKeeper.MintNewTweetNFT(ctx, msg, royaltyRate)
+	
+	batch := types.NewMsgXNFTBatchTransfer(types.PortID, channel, 0, owner,
+		[]string{unlicensable.PrimaryNFTID, licensable.PrimaryNFTID}, licensee.String())
+	if _, err := k.BatchNFTTransfer(ctx, batch); err == nil {
+		t.Fatal("a batch with an unlicensable nft should be refused")
+	}
+	if count := k.GetPendingLicensesCount(ctx, licensable.PrimaryNFTID); count != 0 {
+		t.Fatalf("no license of a refused batch should be pending, got %d", count)
+	}
+}
+
+func TestOnRecvBatchNFTTransferMintsInOrder(t *testing.T) {
+	ctx, k, _, _ := setupKeeper(t, nfts.CoCoContext)
+	
+	owner, licensee := testutil.NewAddr().String(), testutil.NewAddr().String()
+	terms := nfts.DefaultLicenseTerms()
+	first := types.NewBaseNFTPacket("first", "", owner, licensee, "asset-1", "creator", &terms, testutil.Coin(100), sdk.ZeroDec())
+	second := types.NewBaseNFTPacket("second", "", owner, licensee, "asset-2", "creator", &terms, testutil.Coin(100), sdk.ZeroDec())
+	packet := channeltypes.Packet{SourceChannel: channel, DestinationChannel: channel}
+	
+	ids, err := k.OnRecvBatchNFTTransfer(ctx, types.NewPacketBatchNFTTransfer([]types.BaseNFTPacket{first, second}), packet)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, primaryNFTID := range []string{"first", "second"} {
+		if nft, _ := k.GetTweetNFTByID(ctx, ids[i]); nft.PrimaryNFTID != primaryNFTID {
+			t.Fatalf("secondary nft %d should license %s, got %s", i, primaryNFTID, nft.PrimaryNFTID)
+		}
+	}
+	
+	minted := types.NewBaseNFTPacket("third", "secondary", owner, licensee, "asset-3", "creator", &terms, testutil.Coin(100), sdk.ZeroDec())
+	if _, err := k.OnRecvBatchNFTTransfer(ctx, types.NewPacketBatchNFTTransfer([]types.BaseNFTPacket{minted}), packet); err == nil {
+		t.Fatal("a batch should not carry nfts that already have a secondary nft")
+	}
+}
@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	
+	"github.com/FreeFlixMedia/modules/nfts"
+	"github.com/FreeFlixMedia/modules/xnfts/internal/types"
+)
+
+// PayBundleLicensingFee escrows the price of a bundle living on the primary chain.
+func (k Keeper) PayBundleLicensingFee(ctx sdk.Context, msg types.MsgPayBundleLicensingFee) (types.PacketPayBundleLicensingFee, error) {
+	if nfts.GetContextOfCurrentChain() != nfts.CoCoContext {
+		return types.PacketPayBundleLicensingFee{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "bundles can only be licensed to the licensee chain")
+	}
+	
+	if _, err := k.SubtractCoins(ctx, msg.Sender, sdk.Coins{msg.LicensingFee}); err != nil {
+		return types.PacketPayBundleLicensingFee{}, err
+	}
+	
+	return types.NewPacketPayBundleLicensingFee(msg.BundleID, msg.LicensingFee, msg.Sender.String()), nil
+}
+
+// OnRecvBundleLicensingFee splits the price of the bundle across its members and sends the
+// licenses of all of them back to the buyer in a single batch, nothing is licensed when one of
+// the members can not be.
+func (k Keeper) OnRecvBundleLicensingFee(ctx sdk.Context, data types.PacketPayBundleLicensingFee, packet channeltypes.Packet) error {
+	if err := data.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	
+	if nfts.GetContextOfCurrentChain() != nfts.FreeFlixContext {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "bundles only live on the primary chain")
+	}
+	
+	bundle, found := k.nftKeeper.GetBundle(ctx, data.BundleID)
+	if !found {
+		return sdkerrors.Wrapf(nfts.ErrBundleNotFound, "%d", data.BundleID)
+	}
+	
+	if !bundle.Price.IsEqual(data.LicensingFee) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "licensing fee should be %s", bundle.Price)
+	}
+	
+	if !bundle.LicenseTerms.IsChannelPermitted(packet.DestinationChannel) {
+		return sdkerrors.Wrapf(nfts.ErrInvalidLicense, "bundle %d can not be licensed over %s", bundle.ID, packet.DestinationChannel)
+	}
+	
+	owner, err := sdk.AccAddressFromBech32(bundle.Owner)
+	if err != nil {
+		return err
+	}
+	
+	terms := bundle.LicenseTerms
+	members := make([]nfts.BaseTweetNFT, len(bundle.Members))
+	for i, member := range bundle.Members {
+		nft, found := k.GetTweetNFTByID(ctx, member.PrimaryNFTID)
+		if !found {
+			return sdkerrors.Wrap(nfts.ErrNFTNotFound, member.PrimaryNFTID)
+		}
+		
+		// the bundle is only good for as long as its owner may license every member
+		if !k.nftKeeper.IsOwnerOrApproved(ctx, nft, owner) {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "bundle owner can no longer license %s", nft.PrimaryNFTID)
+		} else if !nft.IsLicensable() {
+			return sdkerrors.Wrapf(nfts.ErrInvalidLicense, "%s is no longer licensable", nft.PrimaryNFTID)
+		} else if k.nftKeeper.IsEscrowed(ctx, nft.PrimaryNFTID) {
+			return sdkerrors.Wrap(nfts.ErrNFTEscrowed, nft.PrimaryNFTID)
+		}
+		
+		if err := k.ValidateLicenseCap(ctx, nft); err != nil {
+			return err
+		}
+		
+		bundled := nft
+		bundled.LicenseTerms = &terms
+		if err := k.ValidateLicenseCap(ctx, bundled); err != nil {
+			return err
+		}
+		members[i] = nft
+	}
+	
+	shares := bundle.MemberShares(data.LicensingFee)
+	packets := make([]types.BaseNFTPacket, 0, len(members))
+	for i, nft := range members {
+		if err := k.DistributeLicensingFee(ctx, nft, shares[i]); err != nil {
+			return err
+		}
+		
+		if err := k.AddPendingLicense(ctx, nft.PrimaryNFTID, packet.DestinationPort, packet.DestinationChannel, data.Sender); err != nil {
+			return err
+		}
+		
+		nftPacket := types.NewBaseNFTPacket(nft.PrimaryNFTID, "", nft.PrimaryOwner, data.Sender,
+			nft.AssetID, nft.TwitterHandle, &terms, shares[i], bundle.RevenueShare)
+		nftPacket.Metadata = nft.Metadata
+		nftPacket.Platform = nft.Platform
+		nftPacket.Collection = k.GetCollectionOfNFT(ctx, nft)
+		nftPacket.MasterNFTID = nft.MasterNFTID
+		nftPacket.EditionNumber = nft.EditionNumber
+		packets = append(packets, nftPacket)
+	}
+	
+	batch := types.NewPacketBatchNFTTransfer(packets)
+	return k.XTransfer(ctx, packet.DestinationPort, packet.DestinationChannel, packet.TimeoutHeight, batch.GetBytes())
+}
+
+func (k Keeper) RefundBundleLicensingFee(ctx sdk.Context, data types.PacketPayBundleLicensingFee) error {
+	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	if err != nil {
+		return err
+	}
+	
+	_, err = k.AddCoins(ctx, sender, sdk.Coins{data.LicensingFee})
+	return err
+}
@@ -0,0 +1,62 @@
+package keeper_test
+
+import (
+	"testing"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	
+	"github.com/FreeFlixMedia/modules/internal/testutil"
+	"github.com/FreeFlixMedia/modules/nfts"
+	"github.com/FreeFlixMedia/modules/xnfts/internal/types"
+)
+
+func TestBundleLicensingFeeRefund(t *testing.T) {
+	ctx, k, _, bank := setupKeeper(t, nfts.CoCoContext)
+	
+	buyer := testutil.NewAddr()
+	bank.SetBalance(buyer, 100)
+	
+	msg := types.NewMsgPayBundleLicensingFee(types.PortID, channel, 0, buyer, 1, testutil.Coin(80))
+	packet, err := k.PayBundleLicensingFee(ctx, msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testutil.RequireBalance(t, bank, buyer, 20)
+	
+	if err := k.RefundBundleLicensingFee(ctx, packet); err != nil {
+		t.Fatal(err)
+	}
+	testutil.RequireBalance(t, bank, buyer, 100)
+}
+
+func TestBundleRefusesUnlicensableMembers(t *testing.T) {
+	ctx, k, nftKeeper, bank := setupKeeper(t, nfts.FreeFlixContext)
+	
+	owner, buyer := testutil.NewAddr(), testutil.NewAddr()
+	first, second := mintNFT(t, ctx, nftKeeper, owner), mintNFT(t, ctx, nftKeeper, owner)
+	members := []nfts.BundleMember{nfts.NewBundleMember(first.PrimaryNFTID, sdk.OneDec()), nfts.NewBundleMember(second.PrimaryNFTID, sdk.OneDec())}
+	msg := nfts.MsgCreateBundle{Sender: owner, Name: "bundle", Members: members, Price: testutil.Coin(200),
+		LicenseTerms: nfts.DefaultLicenseTerms(), RevenueShare: sdk.NewDecWithPrec(1, 1)}
+	
+	unlicensed := second
+	unlicensed.LicenseTerms = nil
+	nftKeeper.MintTweetNFT(ctx, unlicensed)
+	if _, err := nftKeeper.CreateBundle(ctx, msg); err == nil {
+		t.Fatal("a bundle with an unlicensable member should be refused")
+	}
+	
+	nftKeeper.MintTweetNFT(ctx, second)
+	bundle, err := nftKeeper.CreateBundle(ctx, msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	
+	// the member stopped being licensable after the bundle was created
+	nftKeeper.MintTweetNFT(ctx, unlicensed)
+	data := types.NewPacketPayBundleLicensingFee(bundle.ID, testutil.Coin(200), buyer.String())
+	if err := k.OnRecvBundleLicensingFee(ctx, data, channeltypes.Packet{DestinationPort: types.PortID, DestinationChannel: channel}); err == nil {
+		t.Fatal("a bundle with an unlicensable member should not be licensed")
+	}
+	testutil.RequireBalance(t, bank, owner, 0)
+}
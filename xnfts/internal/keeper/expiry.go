@@ -0,0 +1,201 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	
+	"github.com/FreeFlixMedia/modules/nfts"
+	"github.com/FreeFlixMedia/modules/xnfts/internal/types"
+)
+
+// LicenseExpiryNoticeRetryBlocks is the number of blocks after which an expiry notice that
+// could not be delivered is sent again.
+const LicenseExpiryNoticeRetryBlocks = 100
+
+func (k Keeper) InsertLicenseExpiryQueue(ctx sdk.Context, license types.ExpiringLicense) {
+	store := ctx.KVStore(k.storeKey)
+	
+	if license.ExpiryHeight != 0 {
+		store.Set(types.GetLicenseExpiryHeightQueueKey(license.ExpiryHeight, license.SecondaryNFTID), k.cdc.MustMarshalBinaryLengthPrefixed(license))
+	} else if !license.ExpiryTime.IsZero() {
+		store.Set(types.GetLicenseExpiryTimeQueueKey(license.ExpiryTime, license.SecondaryNFTID), k.cdc.MustMarshalBinaryLengthPrefixed(license))
+	}
+}
+
+func (k Keeper) GetAllExpiringLicenses(ctx sdk.Context) []types.ExpiringLicense {
+	store := ctx.KVStore(k.storeKey)
+	
+	var licenses []types.ExpiringLicense
+	for _, prefix := range [][]byte{types.LicenseExpiryTimeQueuePrefix, types.LicenseExpiryHeightQueuePrefix} {
+		iterator := sdk.KVStorePrefixIterator(store, prefix)
+		for ; iterator.Valid(); iterator.Next() {
+			var license types.ExpiringLicense
+			k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &license)
+			licenses = append(licenses, license)
+		}
+		iterator.Close()
+	}
+	
+	return licenses
+}
+
+// DequeueExpiredLicenses removes and returns every queued license that expired
+// at or before the current block height and time.
+func (k Keeper) DequeueExpiredLicenses(ctx sdk.Context) []types.ExpiringLicense {
+	store := ctx.KVStore(k.storeKey)
+	
+	var keys [][]byte
+	var licenses []types.ExpiringLicense
+	
+	timeIterator := store.Iterator(types.LicenseExpiryTimeQueuePrefix,
+		sdk.PrefixEndBytes(types.GetLicenseExpiryTimeKey(ctx.BlockTime())))
+	for ; timeIterator.Valid(); timeIterator.Next() {
+		var license types.ExpiringLicense
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(timeIterator.Value(), &license)
+		keys = append(keys, timeIterator.Key())
+		licenses = append(licenses, license)
+	}
+	timeIterator.Close()
+	
+	heightIterator := store.Iterator(types.LicenseExpiryHeightQueuePrefix,
+		sdk.PrefixEndBytes(types.GetLicenseExpiryHeightKey(ctx.BlockHeight())))
+	for ; heightIterator.Valid(); heightIterator.Next() {
+		var license types.ExpiringLicense
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(heightIterator.Value(), &license)
+		keys = append(keys, heightIterator.Key())
+		licenses = append(licenses, license)
+	}
+	heightIterator.Close()
+	
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	
+	return licenses
+}
+
+// ExpireLicense marks the secondary nft inactive and notifies the primary chain.
+func (k Keeper) ExpireLicense(ctx sdk.Context, license types.ExpiringLicense) {
+	nft, found := k.GetTweetNFTByID(ctx, license.SecondaryNFTID)
+	if !found || !nft.IsLicenseActive() {
+		return
+	}
+	
+	nft.LicenseStatus = nfts.LicenseStatusExpired
+	k.MintTweetNFT(ctx, nft)
+	
+	// only licenses granted by the primary owner are tracked on the primary chain
+	if !nft.IsSublicense() {
+		k.NotifyLicenseExpiry(ctx, license, nft.SecondaryOwner)
+	}
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeLicenseExpired,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyPrimaryNFTID, license.PrimaryNFTID),
+			sdk.NewAttribute(types.AttributeKeySecondaryNFTID, license.SecondaryNFTID),
+		),
+	)
+}
+
+// NotifyLicenseExpiry sends the expiry of a license to the primary chain, a notice that can not be
+// sent is retried so the license grant does not stay active on the primary chain.
+func (k Keeper) NotifyLicenseExpiry(ctx sdk.Context, license types.ExpiringLicense, licensee string) {
+	packet := types.NewPacketLicenseExpired(license.PrimaryNFTID, license.SecondaryNFTID, licensee)
+	if err := k.XTimedTransfer(ctx, license.Port, license.Channel, packet.GetBytes()); err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("failed to notify license expiry of %s: %s", license.SecondaryNFTID, err.Error()))
+		k.InsertLicenseExpiryNoticeQueue(ctx, types.LicenseExpiryNotice{
+			License:     license,
+			RetryHeight: ctx.BlockHeight() + LicenseExpiryNoticeRetryBlocks,
+		})
+	}
+}
+
+func (k Keeper) InsertLicenseExpiryNoticeQueue(ctx sdk.Context, notice types.LicenseExpiryNotice) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetLicenseExpiryNoticeQueueKey(notice.RetryHeight, notice.License.SecondaryNFTID),
+		k.cdc.MustMarshalBinaryLengthPrefixed(notice.License))
+}
+
+func (k Keeper) GetAllLicenseExpiryNotices(ctx sdk.Context) []types.LicenseExpiryNotice {
+	store := ctx.KVStore(k.storeKey)
+	
+	iterator := sdk.KVStorePrefixIterator(store, types.LicenseExpiryNoticeQueuePrefix)
+	defer iterator.Close()
+	
+	var notices []types.LicenseExpiryNotice
+	for ; iterator.Valid(); iterator.Next() {
+		var license types.ExpiringLicense
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &license)
+		
+		key := iterator.Key()[len(types.LicenseExpiryNoticeQueuePrefix):]
+		notices = append(notices, types.LicenseExpiryNotice{
+			License:     license,
+			RetryHeight: int64(sdk.BigEndianToUint64(key[:8])),
+		})
+	}
+	
+	return notices
+}
+
+// RetryLicenseExpiryNotices sends again the expiry notices due at the current height, licenses
+// renewed in the meantime are not notified.
+func (k Keeper) RetryLicenseExpiryNotices(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	
+	iterator := store.Iterator(types.LicenseExpiryNoticeQueuePrefix,
+		sdk.PrefixEndBytes(types.GetLicenseExpiryNoticeHeightKey(ctx.BlockHeight())))
+	
+	var keys [][]byte
+	var licenses []types.ExpiringLicense
+	for ; iterator.Valid(); iterator.Next() {
+		var license types.ExpiringLicense
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &license)
+		keys = append(keys, iterator.Key())
+		licenses = append(licenses, license)
+	}
+	iterator.Close()
+	
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	
+	for _, license := range licenses {
+		if nft, found := k.GetTweetNFTByID(ctx, license.SecondaryNFTID); found && !nft.IsLicenseActive() {
+			k.NotifyLicenseExpiry(ctx, license, nft.SecondaryOwner)
+		}
+	}
+}
+
+// OnAcknowledgementLicenseExpired logs the expiries the primary chain refused, the grant they
+// refer to is unknown there and retrying would not change it.
+func (k Keeper) OnAcknowledgementLicenseExpired(ctx sdk.Context, data types.PacketLicenseExpired, ack types.PostCreationPacketAcknowledgement) {
+	if !ack.Success {
+		k.Logger(ctx).Error(fmt.Sprintf("license expiry of %s was refused: %s", data.SecondaryNFTID, ack.Error))
+	}
+}
+
+// OnTimeoutLicenseExpired queues the expiry notice again over the channel it was sent on.
+func (k Keeper) OnTimeoutLicenseExpired(ctx sdk.Context, data types.PacketLicenseExpired, packet channeltypes.Packet) {
+	license := types.NewExpiringLicense(data.PrimaryNFTID, data.SecondaryNFTID, packet.SourcePort, packet.SourceChannel, 0, time.Time{})
+	k.InsertLicenseExpiryNoticeQueue(ctx, types.LicenseExpiryNotice{
+		License:     license,
+		RetryHeight: ctx.BlockHeight() + LicenseExpiryNoticeRetryBlocks,
+	})
+}
+
+func (k Keeper) OnRecvLicenseExpired(ctx sdk.Context, data types.PacketLicenseExpired, packet channeltypes.Packet) error {
+	grant, found := k.nftKeeper.GetLicenseGrant(ctx, data.PrimaryNFTID, packet.DestinationChannel, data.SecondaryNFTID)
+	if !found {
+		return sdkerrors.Wrapf(nfts.ErrInvalidLicense, "license %s of %s not found", data.SecondaryNFTID, data.PrimaryNFTID)
+	}
+	
+	grant.Status = nfts.LicenseStatusExpired
+	k.SetLicenseGrant(ctx, grant)
+	return nil
+}
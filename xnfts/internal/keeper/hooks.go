@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	
+	"github.com/FreeFlixMedia/modules/nfts"
+)
+
+var _ nfts.NFTHooks = Hooks{}
+
+// Hooks keeps the nfts module from burning nfts whose licenses are still in flight.
+type Hooks struct {
+	k Keeper
+}
+
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+func (h Hooks) BeforeNFTBurn(ctx sdk.Context, primaryNFTID string) error {
+	if h.k.GetPendingLicensesCount(ctx, primaryNFTID) > 0 {
+		return sdkerrors.Wrapf(nfts.ErrInvalidLicense, "licenses of %s are pending", primaryNFTID)
+	}
+	return nil
+}
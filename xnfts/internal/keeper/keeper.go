@@ -89,11 +89,11 @@ func (k Keeper) ClaimCapability(ctx sdk.Context, cap *capability.Capability, nam
 
 func (keeper Keeper) PayLicensingFeeAndNFTTransfer(ctx sdk.Context, msg types.MsgPayLicensingFee) (
 	types.PacketPayLicensingFeeAndNFTTransfer, error) {
-	snfts := keeper.GetAllTweetNFTs(ctx)
+	snfts := keeper.nftKeeper.GetTweetsOfAccount(ctx, msg.Sender)
 	
 	for _, _nft := range snfts {
-		if strings.EqualFold(_nft.PrimaryNFTID, msg.PrimaryNFTID) {
-			return types.PacketPayLicensingFeeAndNFTTransfer{}, sdkerrors.Wrap(nfts.ErrInvalidLicense, "primary nfts already licensed")
+		if strings.EqualFold(_nft.PrimaryNFTID, msg.PrimaryNFTID) && _nft.IsLicenseActive() {
+			return types.PacketPayLicensingFeeAndNFTTransfer{}, sdkerrors.Wrap(nfts.ErrInvalidLicense, "primary nfts already licensed to sender")
 		}
 	}
 	
@@ -119,22 +119,45 @@ func (keeper Keeper) UpdateSecondaryNFTOwner(ctx sdk.Context, msg types.MsgXNFTT
 		return types.BaseNFTPacket{}, sdkerrors.Wrap(nfts.ErrNFTNotFound, "")
 	}
 	
-	if !_nft.License {
+	if !_nft.IsLicensable() {
 		return types.BaseNFTPacket{}, sdkerrors.Wrap(nfts.ErrInvalidLicense, fmt.Sprintf("unable to transfer %s", _nft.PrimaryNFTID))
+	} else if !_nft.LicenseTerms.IsChannelPermitted(msg.SourceChannel) {
+		return types.BaseNFTPacket{}, sdkerrors.Wrapf(nfts.ErrInvalidLicense, "%s can not be licensed over %s", _nft.PrimaryNFTID, msg.SourceChannel)
+	} else if keeper.nftKeeper.IsEscrowed(ctx, _nft.PrimaryNFTID) {
+		return types.BaseNFTPacket{}, sdkerrors.Wrap(nfts.ErrNFTEscrowed, _nft.PrimaryNFTID)
 	}
 	
-	if !msg.Sender.Equals(types.GetHexAddressFromBech32String(_nft.PrimaryOwner)) {
-		return types.BaseNFTPacket{}, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "")
+	if _nft.IsCoOwned() {
+		if !_nft.IsCoOwner(msg.Sender.String()) {
+			return types.BaseNFTPacket{}, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "only co-owners can license %s", _nft.PrimaryNFTID)
+		} else if !keeper.nftKeeper.ConsumeLicenseApproval(ctx, _nft.PrimaryNFTID, msg.SourceChannel, msg.Recipient) {
+			return types.BaseNFTPacket{}, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "license of %s to %s is not approved by co-owners", _nft.PrimaryNFTID, msg.Recipient)
+		}
+	} else if !keeper.nftKeeper.IsOwnerOrApproved(ctx, _nft, msg.Sender) {
+		return types.BaseNFTPacket{}, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "sender is neither the primary owner nor approved")
+	}
+	
+	if err := keeper.ValidateLicenseCap(ctx, _nft); err != nil {
+		return types.BaseNFTPacket{}, err
+	}
+	
+	if err := keeper.AddPendingLicense(ctx, _nft.PrimaryNFTID, msg.SourcePort, msg.SourceChannel, msg.Recipient); err != nil {
+		return types.BaseNFTPacket{}, err
 	}
 	
 	packet.PrimaryNFTID = _nft.PrimaryNFTID
 	packet.PrimaryNFTOwner = _nft.PrimaryOwner
-	packet.License = _nft.License
+	packet.LicenseTerms = _nft.LicenseTerms
 	packet.AssetID = _nft.AssetID
 	packet.RevenueShare = _nft.RevenueShare
 	packet.LicensingFee = _nft.LicensingFee
 	packet.SecondaryNFTOwner = msg.Recipient
 	packet.TwitterHandle = _nft.TwitterHandle
+	packet.Metadata = _nft.Metadata
+	packet.Platform = _nft.Platform
+	packet.Collection = keeper.GetCollectionOfNFT(ctx, _nft)
+	packet.MasterNFTID = _nft.MasterNFTID
+	packet.EditionNumber = _nft.EditionNumber
 	
 	return packet, nil
 }
@@ -151,13 +174,15 @@ func (keeper Keeper) CreateSecondaryNFT(ctx sdk.Context, msg types.MsgXNFTTransf
 	sNFTID := nfts.GetSecondaryNFTID(count)
 	
 	packet.PrimaryNFTOwner = msg.Recipient
-	packet.License = true
+	terms := nfts.DefaultLicenseTerms()
+	packet.LicenseTerms = &terms
 	packet.AssetID = msg.AssetID
 	packet.RevenueShare = msg.RevenueShare
 	packet.LicensingFee = msg.LicensingFee
 	packet.SecondaryNFTID = sNFTID
 	packet.SecondaryNFTOwner = msg.Sender.String()
-	packet.TwitterHandle = msg.TwitterHandle
+	packet.Platform = nfts.NormalizePlatform(msg.Platform)
+	packet.TwitterHandle = nfts.NormalizeHandle(packet.Platform, msg.TwitterHandle)
 	
 	keeper.MintTweetNFT(ctx, *packet.ToBaseTweetNFT())
 	keeper.SetTweetIDToAccount(ctx, msg.Sender, sNFTID)
@@ -0,0 +1,49 @@
+package keeper_test
+
+import (
+	"testing"
+	
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/capability"
+	
+	"github.com/FreeFlixMedia/modules/internal/testutil"
+	"github.com/FreeFlixMedia/modules/nfts"
+	"github.com/FreeFlixMedia/modules/xnfts/internal/keeper"
+	"github.com/FreeFlixMedia/modules/xnfts/internal/types"
+)
+
+const channel = "channel-0"
+
+// setupKeeper builds the xnfts keeper of the chain on top of a real nfts keeper, the packets are
+// handed to the receive and acknowledgement paths directly instead of being relayed.
+func setupKeeper(t *testing.T, chain string) (sdk.Context, keeper.Keeper, nfts.Keeper, testutil.BankKeeper) {
+	cdc := codec.New()
+	sdk.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+	nfts.AppModuleBasic{}.RegisterCodec(cdc)
+	types.RegisterCodec(cdc)
+	
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	nftsKey := sdk.NewKVStoreKey(nfts.StoreKey)
+	ctx := testutil.NewContext(t, chain, key, nftsKey)
+	bank := testutil.NewBankKeeper()
+	
+	nftKeeper := nfts.NewKeeper(cdc, nftsKey, bank, testutil.NewSubspace(cdc, nfts.DefaultParamspace))
+	nftKeeper.SetParams(ctx, nfts.DefaultParams())
+	
+	k := keeper.NewKeeper(cdc, key, nftKeeper, bank, nil, nil, capability.ScopedKeeper{})
+	return ctx, k, nftKeeper, bank
+}
+
+// mintNFT mints a licensable primary nft of the owner with a licensing fee of 100.
+func mintNFT(t *testing.T, ctx sdk.Context, nftKeeper nfts.Keeper, owner sdk.AccAddress) nfts.BaseTweetNFT {
+	terms := nfts.DefaultLicenseTerms()
+	msg := nfts.NewMsgMintNFT(owner, "", testutil.NewAddr().String(), &terms, testutil.Coin(100), sdk.NewDecWithPrec(1, 1),
+		0, 0, sdk.ZeroDec(), nil, nfts.PlatformTwitter, "creator", nfts.Metadata{})
+	royaltyRate, err := nftKeeper.ValidateMint(ctx, msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return nftKeeper.MintNewTweetNFT(ctx, msg, royaltyRate)
+}
@@ -0,0 +1,189 @@
+package keeper
+
+import (
+	"strconv"
+	"strings"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	
+	"github.com/FreeFlixMedia/modules/nfts"
+	"github.com/FreeFlixMedia/modules/xnfts/internal/types"
+)
+
+// SetPendingLicense records a license packet sent to the licensee chain, it turns into
+// a license grant once the packet is acknowledged with the secondary nft id.
+func (k Keeper) SetPendingLicense(ctx sdk.Context, primaryNFTID, channel string, sequence uint64, licensee string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPendingLicenseKey(primaryNFTID, channel, sequence), k.cdc.MustMarshalBinaryLengthPrefixed(licensee))
+}
+
+func (k Keeper) GetPendingLicense(ctx sdk.Context, primaryNFTID, channel string, sequence uint64) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	
+	bz := store.Get(types.GetPendingLicenseKey(primaryNFTID, channel, sequence))
+	if bz == nil {
+		return "", false
+	}
+	
+	var licensee string
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &licensee)
+	return licensee, true
+}
+
+func (k Keeper) DeletePendingLicense(ctx sdk.Context, primaryNFTID, channel string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPendingLicenseKey(primaryNFTID, channel, sequence))
+}
+
+func (k Keeper) GetAllPendingLicenses(ctx sdk.Context) []types.PendingLicense {
+	store := ctx.KVStore(k.storeKey)
+	
+	iterator := sdk.KVStorePrefixIterator(store, types.PendingLicensePrefix)
+	defer iterator.Close()
+	
+	var licenses []types.PendingLicense
+	for ; iterator.Valid(); iterator.Next() {
+		// the key ends with the channel and the sequence, the primary nft id is what precedes them
+		parts := strings.Split(string(iterator.Key()[len(types.PendingLicensePrefix):]), "/")
+		if len(parts) < 3 {
+			continue
+		}
+		
+		sequence, err := strconv.ParseUint(parts[len(parts)-1], 10, 64)
+		if err != nil {
+			continue
+		}
+		
+		var licensee string
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &licensee)
+		licenses = append(licenses, types.PendingLicense{
+			PrimaryNFTID: strings.Join(parts[:len(parts)-2], "/"),
+			Channel:      parts[len(parts)-2],
+			Sequence:     sequence,
+			Licensee:     licensee,
+		})
+	}
+	
+	return licenses
+}
+
+func (k Keeper) GetPendingLicensesCount(ctx sdk.Context, primaryNFTID string) int {
+	store := ctx.KVStore(k.storeKey)
+	
+	iterator := sdk.KVStorePrefixIterator(store, types.GetPendingLicensesKey(primaryNFTID))
+	defer iterator.Close()
+	
+	count := 0
+	for ; iterator.Valid(); iterator.Next() {
+		count++
+	}
+	
+	return count
+}
+
+func (k Keeper) ValidateLicenseCap(ctx sdk.Context, nft nfts.BaseTweetNFT) error {
+	licensees := k.GetPendingLicensesCount(ctx, nft.PrimaryNFTID)
+	for _, grant := range k.GetLicenseGrants(ctx, nft.PrimaryNFTID) {
+		if !grant.IsActive() {
+			continue
+		} else if grant.Exclusive {
+			return sdkerrors.Wrapf(nfts.ErrLicenseCapReached, "%s is exclusively licensed to %s", nft.PrimaryNFTID, grant.Licensee)
+		}
+		licensees++
+	}
+	
+	if nft.LicenseCapReached(licensees) {
+		return sdkerrors.Wrapf(nfts.ErrLicenseCapReached, "%s already has %d licensees", nft.PrimaryNFTID, licensees)
+	}
+	return nil
+}
+
+func (k Keeper) AddPendingLicense(ctx sdk.Context, primaryNFTID, sourcePort, sourceChannel, licensee string) error {
+	sequence, found := k.channelKeeper.GetNextSequenceSend(ctx, sourcePort, sourceChannel)
+	if !found {
+		return channeltypes.ErrSequenceSendNotFound
+	}
+	
+	k.SetPendingLicense(ctx, primaryNFTID, sourceChannel, sequence, licensee)
+	return nil
+}
+
+// ValidateReceivedLicenseTerms enforces the license terms of a primary nft on the licensee chain.
+func (k Keeper) ValidateReceivedLicenseTerms(ctx sdk.Context, data types.BaseNFTPacket, sourceChannel string) error {
+	if data.LicenseTerms == nil {
+		return sdkerrors.Wrap(nfts.ErrInvalidLicense, "license terms are empty")
+	}
+	
+	if err := data.LicenseTerms.ValidateBasic(); err != nil {
+		return err
+	}
+	
+	// sublicenses follow the terms enforced when the license they derive from was granted
+	if data.IsSublicense() {
+		return nil
+	}
+	
+	if !data.LicenseTerms.IsChannelPermitted(sourceChannel) {
+		return sdkerrors.Wrapf(nfts.ErrInvalidLicense, "%s can not be licensed over %s", data.PrimaryNFTID, sourceChannel)
+	}
+	
+	if data.LicenseTerms.Exclusive {
+		for _, id := range k.GetSecondaryNFTIDs(ctx, data.PrimaryNFTID) {
+			if nft, found := k.GetTweetNFTByID(ctx, id); found && nft.IsLicenseActive() {
+				return sdkerrors.Wrapf(nfts.ErrInvalidLicense, "%s is exclusively licensed to %s", data.PrimaryNFTID, nft.SecondaryOwner)
+			}
+		}
+	}
+	return nil
+}
+
+// SetSecondaryNFTIndex indexes a secondary nft held on this chain under the primary nft it licenses.
+func (k Keeper) SetSecondaryNFTIndex(ctx sdk.Context, primaryNFTID, secondaryNFTID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetSecondaryNFTIndexKey(primaryNFTID, secondaryNFTID), []byte(secondaryNFTID))
+}
+
+// IndexSecondaryNFTs indexes every secondary nft held on this chain, the nfts received before the
+// index was introduced are only found this way.
+func (k Keeper) IndexSecondaryNFTs(ctx sdk.Context) {
+	for _, nft := range k.nftKeeper.GetAllTweetNFTs(ctx) {
+		if nft.SecondaryNFTID != "" {
+			k.SetSecondaryNFTIndex(ctx, nft.PrimaryNFTID, nft.SecondaryNFTID)
+		}
+	}
+}
+
+func (k Keeper) GetSecondaryNFTIDs(ctx sdk.Context, primaryNFTID string) []string {
+	store := ctx.KVStore(k.storeKey)
+	
+	iterator := sdk.KVStorePrefixIterator(store, types.GetSecondaryNFTsKey(primaryNFTID))
+	defer iterator.Close()
+	
+	var ids []string
+	for ; iterator.Valid(); iterator.Next() {
+		ids = append(ids, string(iterator.Value()))
+	}
+	
+	return ids
+}
+
+func (k Keeper) GetAllSecondaryNFTIndexes(ctx sdk.Context) []types.SecondaryNFTIndex {
+	store := ctx.KVStore(k.storeKey)
+	
+	iterator := sdk.KVStorePrefixIterator(store, types.SecondaryNFTIndexPrefix)
+	defer iterator.Close()
+	
+	var indexes []types.SecondaryNFTIndex
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()[len(types.SecondaryNFTIndexPrefix):]
+		secondaryNFTID := string(iterator.Value())
+		indexes = append(indexes, types.SecondaryNFTIndex{
+			PrimaryNFTID:   string(key[:len(key)-len(secondaryNFTID)-1]),
+			SecondaryNFTID: secondaryNFTID,
+		})
+	}
+	
+	return indexes
+}
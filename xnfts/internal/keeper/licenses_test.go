@@ -0,0 +1,22 @@
+package keeper_test
+
+import (
+	"testing"
+	
+	"github.com/FreeFlixMedia/modules/internal/testutil"
+	"github.com/FreeFlixMedia/modules/nfts"
+)
+
+func TestIndexSecondaryNFTs(t *testing.T) {
+	ctx, k, nftKeeper, _ := setupKeeper(t, nfts.CoCoContext)
+	
+	terms := nfts.DefaultLicenseTerms()
+	nftKeeper.MintTweetNFT(ctx, nfts.BaseTweetNFT{PrimaryNFTID: "primary", SecondaryNFTID: "secondary",
+		SecondaryOwner: testutil.NewAddr().String(), LicenseTerms: &terms, LicenseStatus: nfts.LicenseStatusActive})
+	
+	k.IndexSecondaryNFTs(ctx)
+	
+	if ids := k.GetSecondaryNFTIDs(ctx, "primary"); len(ids) != 1 || ids[0] != "secondary" {
+		t.Fatalf("the received secondary nft should be indexed, got %v", ids)
+	}
+}
@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"fmt"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	
+	"github.com/FreeFlixMedia/modules/xnfts/internal/types"
+)
+
+func (k Keeper) GetMintVoucherNFTID(ctx sdk.Context, creator string, nonce uint64) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	
+	bz := store.Get(types.GetMintVoucherKey(creator, nonce))
+	if bz == nil {
+		return "", false
+	}
+	
+	return string(bz), true
+}
+
+func (k Keeper) SetMintVoucherNFTID(ctx sdk.Context, creator string, nonce uint64, primaryNFTID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetMintVoucherKey(creator, nonce), []byte(primaryNFTID))
+}
+
+// RedeemMintVoucher pays the licensing fee of a voucher from the licensee chain, the fee goes to
+// the creator and the voucher travels with it since the nft may not be minted yet.
+func (k Keeper) RedeemMintVoucher(ctx sdk.Context, msg types.MsgRedeemMintVoucher) (types.PacketPayLicensingFeeAndNFTTransfer, error) {
+	terms := msg.Voucher.Terms
+	if msg.Voucher.IsExpired(ctx.BlockTime()) {
+		return types.PacketPayLicensingFeeAndNFTTransfer{}, sdkerrors.Wrapf(types.ErrInvalidMintVoucher, "voucher %d has expired", terms.Nonce)
+	}
+	
+	if _, err := k.SubtractCoins(ctx, msg.Sender, sdk.Coins{terms.LicensingFee}); err != nil {
+		return types.PacketPayLicensingFeeAndNFTTransfer{}, err
+	}
+	
+	voucher := msg.Voucher
+	packet := types.NewPacketPayLicensingFeeAndNFTTransfer(terms.LicensingFee, terms.Creator, msg.Sender.String(), "")
+	packet.Voucher = &voucher
+	return packet, nil
+}
+
+// OnRecvMintVoucher mints the nft of the voucher to the creator on the first license bought with it
+// and licenses the minted nft, the primary nft id of the license is returned.
+func (k Keeper) OnRecvMintVoucher(ctx sdk.Context, data types.PacketPayLicensingFeeAndNFTTransfer, channel string) (string, error) {
+	voucher := data.Voucher
+	if err := voucher.ValidateBasic(); err != nil {
+		return "", err
+	}
+	
+	terms := voucher.Terms
+	if voucher.IsExpired(ctx.BlockTime()) {
+		return "", sdkerrors.Wrapf(types.ErrInvalidMintVoucher, "voucher %d has expired", terms.Nonce)
+	} else if terms.ChainID != ctx.ChainID() {
+		return "", sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "voucher is signed for chain %s", terms.ChainID)
+	} else if !voucher.Signer().Equals(types.GetHexAddressFromBech32String(terms.Creator)) {
+		return "", sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "voucher is not signed by the creator")
+	} else if data.Recipient != terms.Creator {
+		return "", sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "recipient is not the creator")
+	}
+	
+	primaryNFTID, found := k.GetMintVoucherNFTID(ctx, terms.Creator, terms.Nonce)
+	if !found {
+		msg := terms.ToMsgMintTweetNFT()
+		royaltyRate, err := k.nftKeeper.ValidateMint(ctx, msg)
+		if err != nil {
+			return "", err
+		}
+		
+		nft := k.nftKeeper.MintNewTweetNFT(ctx, msg, royaltyRate)
+		primaryNFTID = nft.PrimaryNFTID
+		k.SetMintVoucherNFTID(ctx, terms.Creator, terms.Nonce, primaryNFTID)
+		
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeMintVoucherRedeemed,
+				sdk.NewAttribute(types.AttributeKeyCreator, terms.Creator),
+				sdk.NewAttribute(types.AttributeKeyPrimaryNFTID, primaryNFTID),
+				sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprintf("%d", terms.Nonce)),
+			),
+		)
+	}
+	
+	data.PrimaryNFTID = primaryNFTID
+	data.Voucher = nil
+	return primaryNFTID, k.OnRecvXNFTTokenTransfer(ctx, data, channel)
+}
+
+func (k Keeper) GetAllMintVoucherRedemptions(ctx sdk.Context) []types.MintVoucherRedemption {
+	store := ctx.KVStore(k.storeKey)
+	
+	iterator := sdk.KVStorePrefixIterator(store, types.MintVoucherPrefix)
+	defer iterator.Close()
+	
+	var redemptions []types.MintVoucherRedemption
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()[len(types.MintVoucherPrefix):]
+		redemptions = append(redemptions, types.MintVoucherRedemption{
+			Creator:      string(key[:len(key)-9]),
+			Nonce:        sdk.BigEndianToUint64(key[len(key)-8:]),
+			PrimaryNFTID: string(iterator.Value()),
+		})
+	}
+	
+	return redemptions
+}
@@ -0,0 +1,70 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	
+	"github.com/FreeFlixMedia/modules/internal/testutil"
+	"github.com/FreeFlixMedia/modules/nfts"
+	"github.com/FreeFlixMedia/modules/xnfts/internal/types"
+)
+
+// signVoucher signs a voucher of the creator for an asset licensed for a fee of 100.
+func signVoucher(t *testing.T, ctx sdk.Context, key secp256k1.PrivKeySecp256k1, creator sdk.AccAddress, chainID string) types.MintVoucher {
+	terms := types.NewMintVoucherTerms(creator.String(), "asset", nfts.PlatformGeneric, "creator", nfts.DefaultLicenseTerms(),
+		testutil.Coin(100), sdk.NewDecWithPrec(1, 1), sdk.ZeroDec(), 0, nfts.Metadata{}, 1, ctx.BlockTime().Add(time.Hour), chainID)
+	signature, err := key.Sign(terms.GetSignBytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return types.NewMintVoucher(terms, key.PubKey(), signature)
+}
+
+func TestMintVoucherMintsOnFirstLicense(t *testing.T) {
+	ctx, k, nftKeeper, bank := setupKeeper(t, nfts.FreeFlixContext)
+	
+	key := secp256k1.GenPrivKey()
+	creator := sdk.AccAddress(key.PubKey().Address())
+	voucher := signVoucher(t, ctx, key, creator, ctx.ChainID())
+	
+	data := types.NewPacketPayLicensingFeeAndNFTTransfer(testutil.Coin(100), creator.String(), testutil.NewAddr().String(), "")
+	data.Voucher = &voucher
+	primaryNFTID, err := k.OnRecvMintVoucher(ctx, data, channel)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nft, found := nftKeeper.GetTweetNFTByID(ctx, primaryNFTID); !found || nft.PrimaryOwner != creator.String() {
+		t.Fatal("the nft of the voucher should be minted to the creator")
+	}
+	testutil.RequireBalance(t, bank, creator, 100)
+	
+	count := nftKeeper.GetGlobalTweetCount(ctx)
+	if again, err := k.OnRecvMintVoucher(ctx, data, channel); err != nil {
+		t.Fatal(err)
+	} else if again != primaryNFTID || nftKeeper.GetGlobalTweetCount(ctx) != count {
+		t.Fatal("a redeemed voucher should license the nft it already minted")
+	}
+	testutil.RequireBalance(t, bank, creator, 200)
+}
+
+func TestRefuseForeignMintVouchers(t *testing.T) {
+	ctx, k, _, bank := setupKeeper(t, nfts.FreeFlixContext)
+	
+	key := secp256k1.GenPrivKey()
+	creator := sdk.AccAddress(key.PubKey().Address())
+	
+	forged := signVoucher(t, ctx, secp256k1.GenPrivKey(), creator, ctx.ChainID())
+	otherChain := signVoucher(t, ctx, key, creator, "other-chain")
+	for _, voucher := range []types.MintVoucher{forged, otherChain} {
+		voucher := voucher
+		data := types.NewPacketPayLicensingFeeAndNFTTransfer(testutil.Coin(100), creator.String(), testutil.NewAddr().String(), "")
+		data.Voucher = &voucher
+		if _, err := k.OnRecvMintVoucher(ctx, data, channel); err == nil {
+			t.Fatal("a voucher not signed by the creator for this chain should be refused")
+		}
+	}
+	testutil.RequireBalance(t, bank, creator, 0)
+}
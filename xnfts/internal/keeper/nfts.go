@@ -33,6 +33,39 @@ func (k Keeper) SetTweetIDToAccount(ctx sdk.Context, addr sdk.AccAddress, id str
 	return
 }
 
+func (k Keeper) SetLicenseGrant(ctx sdk.Context, grant nfts.LicenseGrant) {
+	k.nftKeeper.SetLicenseGrant(ctx, grant)
+	return
+}
+
+func (k Keeper) GetLicenseGrants(ctx sdk.Context, primaryNFTID string) []nfts.LicenseGrant {
+	return k.nftKeeper.GetLicenseGrants(ctx, primaryNFTID)
+}
+
+// GetCollectionOfNFT returns the collection the nft was minted into, nil for tweet nfts.
+func (k Keeper) GetCollectionOfNFT(ctx sdk.Context, nft nfts.BaseTweetNFT) *nfts.Collection {
+	if nft.CollectionID == "" {
+		return nil
+	}
+	
+	collection, found := k.nftKeeper.GetCollection(ctx, nft.CollectionID)
+	if !found {
+		return nil
+	}
+	return &collection
+}
+
+// MirrorCollection stores a collection received from the partner chain unless it is already known.
+func (k Keeper) MirrorCollection(ctx sdk.Context, collection *nfts.Collection) {
+	if collection == nil {
+		return
+	}
+	
+	if _, found := k.nftKeeper.GetCollection(ctx, collection.ID); !found {
+		k.nftKeeper.SetCollection(ctx, *collection)
+	}
+}
+
 func (k Keeper) AddCoins(ctx sdk.Context, addr sdk.AccAddress, amount sdk.Coins) (sdk.Coins, error) {
 	return k.bankKeeper.AddCoins(ctx, addr, amount)
 }
@@ -40,3 +73,21 @@ func (k Keeper) AddCoins(ctx sdk.Context, addr sdk.AccAddress, amount sdk.Coins)
 func (k Keeper) SubtractCoins(ctx sdk.Context, addr sdk.AccAddress, amount sdk.Coins) (sdk.Coins, error) {
 	return k.bankKeeper.SubtractCoins(ctx, addr, amount)
 }
+
+// DistributeLicensingFee credits a licensing fee received by the primary nft across its revenue splits.
+func (k Keeper) DistributeLicensingFee(ctx sdk.Context, nft nfts.BaseTweetNFT, fee sdk.Coin) error {
+	payouts, err := k.nftKeeper.Payouts(ctx, nft, fee)
+	if err != nil {
+		return err
+	}
+	
+	for _, payout := range payouts {
+		if !payout.Amount.IsPositive() {
+			continue
+		}
+		if _, err := k.AddCoins(ctx, payout.Address, sdk.Coins{payout.Amount}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
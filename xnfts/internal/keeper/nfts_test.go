@@ -0,0 +1,31 @@
+package keeper_test
+
+import (
+	"testing"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	
+	"github.com/FreeFlixMedia/modules/internal/testutil"
+	"github.com/FreeFlixMedia/modules/nfts"
+)
+
+func TestDistributeLicensingFee(t *testing.T) {
+	ctx, k, _, bank := setupKeeper(t, nfts.FreeFlixContext)
+	
+	owner, collaborator := testutil.NewAddr(), testutil.NewAddr()
+	nft := nfts.BaseTweetNFT{
+		PrimaryNFTID: nfts.GetPrimaryNFTID(0),
+		PrimaryOwner: owner.String(),
+		RevenueSplits: []nfts.RevenueSplit{
+			nfts.NewRevenueSplit(owner.String(), sdk.NewDecWithPrec(6, 1)),
+			nfts.NewRevenueSplit(collaborator.String(), sdk.NewDecWithPrec(4, 1)),
+		},
+	}
+	
+	if err := k.DistributeLicensingFee(ctx, nft, testutil.Coin(100)); err != nil {
+		t.Fatal(err)
+	}
+	
+	testutil.RequireBalance(t, bank, owner, 60)
+	testutil.RequireBalance(t, bank, collaborator, 40)
+}
@@ -0,0 +1,474 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	
+	"github.com/FreeFlixMedia/modules/nfts"
+	"github.com/FreeFlixMedia/modules/xnfts/internal/types"
+)
+
+func (k Keeper) GetLicenseOfferCount(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	
+	bz := store.Get(types.LicenseOfferCountKey)
+	if bz == nil {
+		return 0
+	}
+	
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) SetLicenseOfferCount(ctx sdk.Context, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LicenseOfferCountKey, sdk.Uint64ToBigEndian(count))
+}
+
+func (k Keeper) SetLicenseOffer(ctx sdk.Context, offer types.LicenseOffer) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetLicenseOfferKey(offer.Channel, offer.ID), k.cdc.MustMarshalBinaryLengthPrefixed(offer))
+}
+
+func (k Keeper) GetLicenseOffer(ctx sdk.Context, channel string, id uint64) (types.LicenseOffer, bool) {
+	store := ctx.KVStore(k.storeKey)
+	
+	bz := store.Get(types.GetLicenseOfferKey(channel, id))
+	if bz == nil {
+		return types.LicenseOffer{}, false
+	}
+	
+	var offer types.LicenseOffer
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &offer)
+	return offer, true
+}
+
+func (k Keeper) GetAllLicenseOffers(ctx sdk.Context) []types.LicenseOffer {
+	store := ctx.KVStore(k.storeKey)
+	
+	iterator := sdk.KVStorePrefixIterator(store, types.LicenseOfferPrefix)
+	defer iterator.Close()
+	
+	var offers []types.LicenseOffer
+	for ; iterator.Valid(); iterator.Next() {
+		var offer types.LicenseOffer
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &offer)
+		offers = append(offers, offer)
+	}
+	
+	return offers
+}
+
+func (k Keeper) GetLicenseOffersOfNFT(ctx sdk.Context, primaryNFTID string) []types.LicenseOffer {
+	offers := make([]types.LicenseOffer, 0)
+	for _, offer := range k.GetAllLicenseOffers(ctx) {
+		if offer.PrimaryNFTID == primaryNFTID {
+			offers = append(offers, offer)
+		}
+	}
+	
+	return offers
+}
+
+func (k Keeper) InsertLicenseOfferQueue(ctx sdk.Context, offer types.LicenseOffer) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetLicenseOfferQueueKey(offer.Expiry, offer.Channel, offer.ID), types.GetLicenseOfferKey(offer.Channel, offer.ID))
+}
+
+func (k Keeper) RemoveFromLicenseOfferQueue(ctx sdk.Context, offer types.LicenseOffer) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetLicenseOfferQueueKey(offer.Expiry, offer.Channel, offer.ID))
+}
+
+// DequeueExpiredLicenseOffers removes and returns every pending offer that expired at or before the current block time.
+func (k Keeper) DequeueExpiredLicenseOffers(ctx sdk.Context) []types.LicenseOffer {
+	store := ctx.KVStore(k.storeKey)
+	
+	iterator := store.Iterator(types.LicenseOfferQueuePrefix,
+		sdk.PrefixEndBytes(types.GetLicenseOfferQueueTimeKey(ctx.BlockTime())))
+	
+	var keys [][]byte
+	var offers []types.LicenseOffer
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+		
+		bz := store.Get(iterator.Value())
+		if bz == nil {
+			continue
+		}
+		
+		var offer types.LicenseOffer
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &offer)
+		if offer.IsPending() {
+			offers = append(offers, offer)
+		}
+	}
+	iterator.Close()
+	
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	
+	return offers
+}
+
+// MakeLicenseOffer escrows the offered fee on the licensee chain until the primary owner responds.
+func (k Keeper) MakeLicenseOffer(ctx sdk.Context, msg types.MsgMakeLicenseOffer) (types.LicenseOffer, error) {
+	if nfts.GetContextOfCurrentChain() != nfts.CoCoContext {
+		return types.LicenseOffer{}, sdkerrors.Wrap(types.ErrInvalidLicenseOffer, "offers are made from the licensee chain")
+	}
+	
+	for _, nft := range k.nftKeeper.GetTweetsOfAccount(ctx, msg.Sender) {
+		if nft.PrimaryNFTID == msg.PrimaryNFTID && nft.IsLicenseActive() {
+			return types.LicenseOffer{}, sdkerrors.Wrap(nfts.ErrInvalidLicense, "primary nfts already licensed to sender")
+		}
+	}
+	
+	if _, err := k.SubtractCoins(ctx, msg.Sender, sdk.Coins{msg.Fee}); err != nil {
+		return types.LicenseOffer{}, err
+	}
+	
+	id := k.GetLicenseOfferCount(ctx)
+	expiry := ctx.BlockTime().Add(time.Duration(msg.ExpirySeconds) * time.Second)
+	offer := types.NewLicenseOffer(id, msg.PrimaryNFTID, msg.Sender.String(), msg.Recipient, msg.Fee,
+		msg.RevenueShare, msg.DurationBlocks, expiry, msg.SrcPort, msg.SrcChannel)
+	
+	k.SetLicenseOffer(ctx, offer)
+	k.InsertLicenseOfferQueue(ctx, offer)
+	k.SetLicenseOfferCount(ctx, id+1)
+	return offer, nil
+}
+
+// RefundLicenseOffer releases the escrowed fee of a pending offer back to the offerer.
+func (k Keeper) RefundLicenseOffer(ctx sdk.Context, offer types.LicenseOffer, status string) error {
+	if !offer.IsPending() {
+		return nil
+	}
+	
+	offerer, err := sdk.AccAddressFromBech32(offer.Offerer)
+	if err != nil {
+		return err
+	}
+	
+	if _, err := k.AddCoins(ctx, offerer, sdk.Coins{offer.Fee}); err != nil {
+		return err
+	}
+	
+	k.RemoveFromLicenseOfferQueue(ctx, offer)
+	offer.Status = status
+	k.SetLicenseOffer(ctx, offer)
+	return nil
+}
+
+func (k Keeper) OnRecvLicenseOffer(ctx sdk.Context, data types.PacketLicenseOffer, packet channeltypes.Packet) error {
+	if err := data.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidLicenseOffer, err.Error())
+	}
+	
+	if !ctx.BlockTime().Before(data.Expiry) {
+		return sdkerrors.Wrapf(types.ErrInvalidLicenseOffer, "offer %d has expired", data.OfferID)
+	}
+	
+	nft, found := k.GetTweetNFTByID(ctx, data.PrimaryNFTID)
+	if !found {
+		return sdkerrors.Wrap(nfts.ErrNFTNotFound, data.PrimaryNFTID)
+	}
+	
+	if !nft.IsLicensable() {
+		return sdkerrors.Wrap(nfts.ErrInvalidLicense, fmt.Sprintf("unable to license %s", nft.PrimaryNFTID))
+	} else if !nft.LicenseTerms.IsChannelPermitted(packet.DestinationChannel) {
+		return sdkerrors.Wrapf(nfts.ErrInvalidLicense, "%s can not be licensed over %s", nft.PrimaryNFTID, packet.DestinationChannel)
+	} else if nft.PrimaryOwner != data.Recipient {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "recipient is not the primary owner")
+	} else if nft.IsCoOwned() {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is co-owned, its licenses are approved through proposals", nft.PrimaryNFTID)
+	} else if k.nftKeeper.IsEscrowed(ctx, nft.PrimaryNFTID) {
+		return sdkerrors.Wrap(nfts.ErrNFTEscrowed, nft.PrimaryNFTID)
+	}
+	
+	if _, found := k.GetLicenseOffer(ctx, packet.DestinationChannel, data.OfferID); found {
+		return sdkerrors.Wrapf(types.ErrInvalidLicenseOffer, "offer %d already exists", data.OfferID)
+	}
+	
+	offer := data.ToLicenseOffer(packet.DestinationPort, packet.DestinationChannel)
+	k.SetLicenseOffer(ctx, offer)
+	k.InsertLicenseOfferQueue(ctx, offer)
+	return nil
+}
+
+func (k Keeper) getOfferForResponse(ctx sdk.Context, sender sdk.AccAddress, channel string, id uint64) (
+	types.LicenseOffer, nfts.BaseTweetNFT, error) {
+	if nfts.GetContextOfCurrentChain() != nfts.FreeFlixContext {
+		return types.LicenseOffer{}, nfts.BaseTweetNFT{}, sdkerrors.Wrap(types.ErrInvalidLicenseOffer, "offers are answered on the primary chain")
+	}
+	
+	offer, found := k.GetLicenseOffer(ctx, channel, id)
+	if !found {
+		return types.LicenseOffer{}, nfts.BaseTweetNFT{}, sdkerrors.Wrapf(types.ErrLicenseOfferNotFound, "%s/%d", channel, id)
+	} else if !offer.IsPending() {
+		return types.LicenseOffer{}, nfts.BaseTweetNFT{}, sdkerrors.Wrapf(types.ErrInvalidLicenseOffer, "offer %d is %s", id, offer.Status)
+	} else if !ctx.BlockTime().Before(offer.Expiry) {
+		return types.LicenseOffer{}, nfts.BaseTweetNFT{}, sdkerrors.Wrapf(types.ErrInvalidLicenseOffer, "offer %d has expired", id)
+	}
+	
+	nft, found := k.GetTweetNFTByID(ctx, offer.PrimaryNFTID)
+	if !found {
+		return types.LicenseOffer{}, nfts.BaseTweetNFT{}, sdkerrors.Wrap(nfts.ErrNFTNotFound, offer.PrimaryNFTID)
+	}
+	
+	if !k.nftKeeper.IsOwnerOrApproved(ctx, nft, sender) {
+		return types.LicenseOffer{}, nfts.BaseTweetNFT{}, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "sender is neither the primary owner nor approved")
+	}
+	
+	return offer, nft, nil
+}
+
+// AcceptLicenseOffer asks the licensee chain to release the escrow, the license is sent
+// once the licensee chain acknowledges the offer was still open. The license slot is held
+// as a pending license of the response packet until then.
+func (k Keeper) AcceptLicenseOffer(ctx sdk.Context, msg types.MsgAcceptLicenseOffer) (types.LicenseOffer, error) {
+	offer, nft, err := k.getOfferForResponse(ctx, msg.Sender, msg.Channel, msg.OfferID)
+	if err != nil {
+		return types.LicenseOffer{}, err
+	}
+	
+	if !nft.IsLicensable() {
+		return types.LicenseOffer{}, sdkerrors.Wrap(nfts.ErrInvalidLicense, fmt.Sprintf("unable to license %s", nft.PrimaryNFTID))
+	} else if !nft.LicenseTerms.IsChannelPermitted(offer.Channel) {
+		return types.LicenseOffer{}, sdkerrors.Wrapf(nfts.ErrInvalidLicense, "%s can not be licensed over %s", nft.PrimaryNFTID, offer.Channel)
+	} else if nft.IsCoOwned() {
+		// offers made before the co-owners were set
+		return types.LicenseOffer{}, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is co-owned, its licenses are approved through proposals", nft.PrimaryNFTID)
+	} else if k.nftKeeper.IsEscrowed(ctx, nft.PrimaryNFTID) {
+		return types.LicenseOffer{}, sdkerrors.Wrap(nfts.ErrNFTEscrowed, nft.PrimaryNFTID)
+	}
+	
+	if err := k.ValidateLicenseCap(ctx, nft); err != nil {
+		return types.LicenseOffer{}, err
+	}
+	
+	if err := k.AddPendingLicense(ctx, nft.PrimaryNFTID, offer.Port, offer.Channel, offer.Offerer); err != nil {
+		return types.LicenseOffer{}, err
+	}
+	
+	packet := types.NewPacketLicenseOfferResponse(offer.ID, offer.PrimaryNFTID, true)
+	if err := k.XTimedTransfer(ctx, offer.Port, offer.Channel, packet.GetBytes()); err != nil {
+		return types.LicenseOffer{}, err
+	}
+	
+	k.RemoveFromLicenseOfferQueue(ctx, offer)
+	offer.Status = types.OfferStatusAccepted
+	k.SetLicenseOffer(ctx, offer)
+	return offer, nil
+}
+
+func (k Keeper) RejectLicenseOffer(ctx sdk.Context, msg types.MsgRejectLicenseOffer) (types.LicenseOffer, error) {
+	offer, _, err := k.getOfferForResponse(ctx, msg.Sender, msg.Channel, msg.OfferID)
+	if err != nil {
+		return types.LicenseOffer{}, err
+	}
+	
+	packet := types.NewPacketLicenseOfferResponse(offer.ID, offer.PrimaryNFTID, false)
+	if err := k.XTimedTransfer(ctx, offer.Port, offer.Channel, packet.GetBytes()); err != nil {
+		return types.LicenseOffer{}, err
+	}
+	
+	k.RemoveFromLicenseOfferQueue(ctx, offer)
+	offer.Status = types.OfferStatusRejected
+	k.SetLicenseOffer(ctx, offer)
+	return offer, nil
+}
+
+// OnRecvLicenseOfferResponse settles the escrow of an offer that is still open on the licensee chain,
+// or returns the escrow of an accepted offer the primary chain could not grant the license of.
+func (k Keeper) OnRecvLicenseOfferResponse(ctx sdk.Context, data types.PacketLicenseOfferResponse, packet channeltypes.Packet) error {
+	offer, found := k.GetLicenseOffer(ctx, packet.DestinationChannel, data.OfferID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrLicenseOfferNotFound, "%s/%d", packet.DestinationChannel, data.OfferID)
+	}
+	
+	if data.Refund {
+		if offer.Status != types.OfferStatusAccepted {
+			return sdkerrors.Wrapf(types.ErrInvalidLicenseOffer, "offer %d is %s", data.OfferID, offer.Status)
+		}
+		
+		offerer, err := sdk.AccAddressFromBech32(offer.Offerer)
+		if err != nil {
+			return err
+		}
+		if _, err := k.AddCoins(ctx, offerer, sdk.Coins{offer.Fee}); err != nil {
+			return err
+		}
+		
+		offer.Status = types.OfferStatusRefunded
+		k.SetLicenseOffer(ctx, offer)
+		return nil
+	}
+	
+	if !offer.IsPending() {
+		return sdkerrors.Wrapf(types.ErrInvalidLicenseOffer, "offer %d is %s", data.OfferID, offer.Status)
+	} else if !ctx.BlockTime().Before(offer.Expiry) {
+		return sdkerrors.Wrapf(types.ErrInvalidLicenseOffer, "offer %d has expired", data.OfferID)
+	}
+	
+	if !data.Accepted {
+		return k.RefundLicenseOffer(ctx, offer, types.OfferStatusRejected)
+	}
+	
+	k.RemoveFromLicenseOfferQueue(ctx, offer)
+	offer.Status = types.OfferStatusAccepted
+	k.SetLicenseOffer(ctx, offer)
+	return nil
+}
+
+// CompleteLicenseOffer pays the primary owner out of the released escrow and sends the license
+// on the offered terms.
+func (k Keeper) CompleteLicenseOffer(ctx sdk.Context, offer types.LicenseOffer) error {
+	nft, found := k.GetTweetNFTByID(ctx, offer.PrimaryNFTID)
+	if !found {
+		return sdkerrors.Wrap(nfts.ErrNFTNotFound, offer.PrimaryNFTID)
+	}
+	
+	owner, err := sdk.AccAddressFromBech32(nft.PrimaryOwner)
+	if err != nil {
+		return err
+	}
+	
+	if err := k.DistributeLicensingFee(ctx, nft, offer.Fee); err != nil {
+		return err
+	}
+	
+	msg := types.NewMsgXNFTTransfer(offer.Port, offer.Channel, 0, owner, types.NFTInput{
+		PrimaryNFTID: offer.PrimaryNFTID,
+		Recipient:    offer.Offerer,
+		LicensingFee: offer.Fee,
+		RevenueShare: offer.RevenueShare,
+	})
+	
+	return k.XNFTTransferOnOfferedTerms(ctx, msg, offer.DurationBlocks, 0)
+}
+
+// XNFTTransferOnOfferedTerms sends a license with the fee and revenue share of the message,
+// a non zero duration replaces the duration of the primary nft terms.
+func (k Keeper) XNFTTransferOnOfferedTerms(ctx sdk.Context, msg types.MsgXNFTTransfer, durationBlocks, durationSeconds int64) error {
+	packet, err := k.UpdateSecondaryNFTOwner(ctx, msg)
+	if err != nil {
+		return err
+	}
+	
+	packet.LicensingFee = msg.LicensingFee
+	packet.RevenueShare = msg.RevenueShare
+	if durationBlocks > 0 || durationSeconds > 0 {
+		terms := *packet.LicenseTerms
+		terms.DurationBlocks = durationBlocks
+		terms.DurationSeconds = durationSeconds
+		packet.LicenseTerms = &terms
+	}
+	
+	return k.XTimedTransfer(ctx, msg.SourcePort, msg.SourceChannel, packet.GetBytes())
+}
+
+// OnAcknowledgementLicenseOfferResponse grants the license of an accepted offer whose escrow was
+// released, a license that can not be granted anymore has the escrow sent back to the offerer.
+func (k Keeper) OnAcknowledgementLicenseOfferResponse(ctx sdk.Context, data types.PacketLicenseOfferResponse,
+	ack types.PostCreationPacketAcknowledgement, packet channeltypes.Packet) error {
+	if data.Refund {
+		if !ack.Success {
+			k.Logger(ctx).Error(fmt.Sprintf("refund of license offer %d was refused: %s", data.OfferID, ack.Error))
+		}
+		return nil
+	} else if !data.Accepted {
+		return nil
+	}
+	
+	k.DeletePendingLicense(ctx, data.PrimaryNFTID, packet.SourceChannel, packet.Sequence)
+	offer, found := k.GetLicenseOffer(ctx, packet.SourceChannel, data.OfferID)
+	if !found {
+		return nil
+	}
+	
+	if !ack.Success {
+		offer.Status = types.OfferStatusExpired
+		k.SetLicenseOffer(ctx, offer)
+		return nil
+	}
+	
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.CompleteLicenseOffer(cacheCtx, offer); err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("failed to grant license of offer %d: %s", offer.ID, err.Error()))
+		
+		offer.Status = types.OfferStatusRefunded
+		k.SetLicenseOffer(ctx, offer)
+		k.sendLicenseOfferRefund(ctx, offer.ID, offer.PrimaryNFTID, offer.Port, offer.Channel)
+		return nil
+	}
+	
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return nil
+}
+
+func (k Keeper) OnTimeoutLicenseOfferResponse(ctx sdk.Context, data types.PacketLicenseOfferResponse, packet channeltypes.Packet) {
+	if data.Refund {
+		k.sendLicenseOfferRefund(ctx, data.OfferID, data.PrimaryNFTID, packet.SourcePort, packet.SourceChannel)
+		return
+	} else if !data.Accepted {
+		return
+	}
+	
+	k.DeletePendingLicense(ctx, data.PrimaryNFTID, packet.SourceChannel, packet.Sequence)
+	offer, found := k.GetLicenseOffer(ctx, packet.SourceChannel, data.OfferID)
+	if !found {
+		return
+	}
+	
+	offer.Status = types.OfferStatusExpired
+	k.SetLicenseOffer(ctx, offer)
+}
+
+// sendLicenseOfferRefund asks the licensee chain to return the released escrow of an offer to
+// the offerer, the refund is sent again when it times out.
+func (k Keeper) sendLicenseOfferRefund(ctx sdk.Context, offerID uint64, primaryNFTID, port, channel string) {
+	packet := types.NewPacketLicenseOfferResponse(offerID, primaryNFTID, true)
+	packet.Refund = true
+	if err := k.XTimedTransfer(ctx, port, channel, packet.GetBytes()); err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("failed to refund license offer %d: %s", offerID, err.Error()))
+	}
+}
+
+func (k Keeper) OnFailedLicenseOffer(ctx sdk.Context, data types.PacketLicenseOffer, packet channeltypes.Packet, status string) error {
+	offer, found := k.GetLicenseOffer(ctx, packet.SourceChannel, data.OfferID)
+	if !found {
+		return nil
+	}
+	
+	return k.RefundLicenseOffer(ctx, offer, status)
+}
+
+// ExpireLicenseOffers refunds the escrow of the lapsed offers on the licensee chain and
+// closes them on the primary chain.
+func (k Keeper) ExpireLicenseOffers(ctx sdk.Context) {
+	for _, offer := range k.DequeueExpiredLicenseOffers(ctx) {
+		if nfts.GetContextOfCurrentChain() == nfts.CoCoContext {
+			if err := k.RefundLicenseOffer(ctx, offer, types.OfferStatusExpired); err != nil {
+				k.Logger(ctx).Error(fmt.Sprintf("failed to refund license offer %d: %s", offer.ID, err.Error()))
+				continue
+			}
+		} else {
+			offer.Status = types.OfferStatusExpired
+			k.SetLicenseOffer(ctx, offer)
+		}
+		
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeLicenseOfferExpired,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeyPrimaryNFTID, offer.PrimaryNFTID),
+				sdk.NewAttribute(types.AttributeKeyOfferID, fmt.Sprintf("%d", offer.ID)),
+			),
+		)
+	}
+}
@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	"testing"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	
+	"github.com/FreeFlixMedia/modules/internal/testutil"
+	"github.com/FreeFlixMedia/modules/nfts"
+	"github.com/FreeFlixMedia/modules/xnfts/internal/keeper"
+	"github.com/FreeFlixMedia/modules/xnfts/internal/types"
+)
+
+func makeLicenseOffer(t *testing.T, ctx sdk.Context, k keeper.Keeper, offerer sdk.AccAddress) types.LicenseOffer {
+	msg := types.NewMsgMakeLicenseOffer(types.PortID, channel, 0, offerer, testutil.NewAddr().String(), nfts.GetPrimaryNFTID(0),
+		testutil.Coin(70), sdk.NewDecWithPrec(1, 1), 100, 3600)
+	offer, err := k.MakeLicenseOffer(ctx, msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return offer
+}
+
+func TestRejectedLicenseOfferRefund(t *testing.T) {
+	ctx, k, _, bank := setupKeeper(t, nfts.CoCoContext)
+	
+	offerer := testutil.NewAddr()
+	bank.SetBalance(offerer, 100)
+	
+	offer := makeLicenseOffer(t, ctx, k, offerer)
+	testutil.RequireBalance(t, bank, offerer, 30)
+	
+	response := types.NewPacketLicenseOfferResponse(offer.ID, offer.PrimaryNFTID, false)
+	if err := k.OnRecvLicenseOfferResponse(ctx, response, channeltypes.Packet{DestinationChannel: channel}); err != nil {
+		t.Fatal(err)
+	}
+	
+	testutil.RequireBalance(t, bank, offerer, 100)
+	if offer, _ := k.GetLicenseOffer(ctx, channel, offer.ID); offer.Status != types.OfferStatusRejected {
+		t.Fatalf("expected offer to be %s, got %s", types.OfferStatusRejected, offer.Status)
+	}
+}
+
+func TestAcceptedLicenseOfferRefund(t *testing.T) {
+	ctx, k, _, bank := setupKeeper(t, nfts.CoCoContext)
+	
+	offerer := testutil.NewAddr()
+	bank.SetBalance(offerer, 100)
+	
+	offer := makeLicenseOffer(t, ctx, k, offerer)
+	packet := channeltypes.Packet{DestinationChannel: channel}
+	
+	accepted := types.NewPacketLicenseOfferResponse(offer.ID, offer.PrimaryNFTID, true)
+	if err := k.OnRecvLicenseOfferResponse(ctx, accepted, packet); err != nil {
+		t.Fatal(err)
+	}
+	// the escrow of an accepted offer is released to the primary chain
+	testutil.RequireBalance(t, bank, offerer, 30)
+	
+	refund := accepted
+	refund.Refund = true
+	if err := k.OnRecvLicenseOfferResponse(ctx, refund, packet); err != nil {
+		t.Fatal(err)
+	}
+	testutil.RequireBalance(t, bank, offerer, 100)
+	
+	if err := k.OnRecvLicenseOfferResponse(ctx, refund, packet); err == nil {
+		t.Fatal("an offer should only be refunded once")
+	}
+	testutil.RequireBalance(t, bank, offerer, 100)
+	
+	if offer, _ := k.GetLicenseOffer(ctx, channel, offer.ID); offer.Status != types.OfferStatusRefunded {
+		t.Fatalf("expected offer to be %s, got %s", types.OfferStatusRefunded, offer.Status)
+	}
+}
@@ -1 +1,56 @@
 package keeper
+
+import (
+	"strconv"
+	
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	abcitypes "github.com/tendermint/tendermint/abci/types"
+	
+	"github.com/FreeFlixMedia/modules/xnfts/internal/types"
+)
+
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abcitypes.RequestQuery) (bytes []byte, err error) {
+		switch path[0] {
+		case types.QueryLicenseOffers:
+			return queryLicenseOffers(ctx, path[1:], k)
+		case types.QueryMintVoucher:
+			return queryMintVoucher(ctx, path[1:], k)
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query path: %s", path[0])
+		}
+		
+	}
+}
+
+func queryLicenseOffers(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
+	offers := k.GetLicenseOffersOfNFT(ctx, path[0])
+	
+	res, err := codec.MarshalJSONIndent(k.cdc, offers)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	
+	return res, nil
+}
+
+func queryMintVoucher(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
+	nonce, err := strconv.ParseUint(path[1], 10, 64)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	
+	primaryNFTID, found := k.GetMintVoucherNFTID(ctx, path[0], nonce)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidMintVoucher, "voucher %d of %s is not redeemed", nonce, path[0])
+	}
+	
+	res, err := codec.MarshalJSONIndent(k.cdc, types.MintVoucherRedemption{Creator: path[0], Nonce: nonce, PrimaryNFTID: primaryNFTID})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	
+	return res, nil
+}
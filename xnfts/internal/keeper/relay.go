@@ -1,6 +1,9 @@
 package keeper
 
 import (
+	"fmt"
+	"time"
+	
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
@@ -14,6 +17,10 @@ const (
 	DefaultPacketTimeoutHeight = 1000
 	
 	DefaultPacketTimeoutTimestamp = 0
+	
+	// DefaultPacketTimeoutDuration bounds the packets sent by the module itself
+	// which have no counterparty height to derive a timeout height from.
+	DefaultPacketTimeoutDuration = 24 * time.Hour
 )
 
 func (k Keeper) XTransfer(
@@ -22,6 +29,26 @@ func (k Keeper) XTransfer(
 	destHeight uint64,
 	packetData []byte,
 ) error {
+	// TODO : DestHeight need to be updated with src header.height
+	return k.sendPacket(ctx, sourcePort, sourceChannel, destHeight+DefaultPacketTimeoutHeight, DefaultPacketTimeoutTimestamp, packetData)
+}
+
+// XTimedTransfer sends a packet that times out after DefaultPacketTimeoutDuration.
+func (k Keeper) XTimedTransfer(
+	ctx sdk.Context,
+	sourcePort, sourceChannel string,
+	packetData []byte,
+) error {
+	timeoutTimestamp := uint64(ctx.BlockTime().Add(DefaultPacketTimeoutDuration).UnixNano())
+	return k.sendPacket(ctx, sourcePort, sourceChannel, 0, timeoutTimestamp, packetData)
+}
+
+func (k Keeper) sendPacket(
+	ctx sdk.Context,
+	sourcePort, sourceChannel string,
+	timeoutHeight, timeoutTimestamp uint64,
+	packetData []byte,
+) error {
 	
 	sourceChannelEnd, found := k.channelKeeper.GetChannel(ctx, sourcePort, sourceChannel)
 	if !found {
@@ -36,7 +63,8 @@ func (k Keeper) XTransfer(
 		return channeltypes.ErrSequenceSendNotFound
 	}
 	
-	return k.createOutgoingPacket(ctx, sequence, sourcePort, sourceChannel, destinationPort, destinationChannel, destHeight, packetData)
+	return k.createOutgoingPacket(ctx, sequence, sourcePort, sourceChannel, destinationPort, destinationChannel,
+		timeoutHeight, timeoutTimestamp, packetData)
 }
 
 func (k Keeper) createOutgoingPacket(
@@ -44,7 +72,7 @@ func (k Keeper) createOutgoingPacket(
 	seq uint64,
 	sourcePort, sourceChannel string,
 	destinationPort, destinationChannel string,
-	destHeight uint64,
+	timeoutHeight, timeoutTimestamp uint64,
 	data []byte,
 ) error {
 	
@@ -60,53 +88,99 @@ func (k Keeper) createOutgoingPacket(
 		sourceChannel,
 		destinationPort,
 		destinationChannel,
-		destHeight+DefaultPacketTimeoutHeight, // TODO : DestHeight need to be updated with src header.height
-		DefaultPacketTimeoutTimestamp,
+		timeoutHeight,
+		timeoutTimestamp,
 	)
 	
 	return k.channelKeeper.SendPacket(ctx, channelCap, packet)
 }
 
-func (k Keeper) OnRecvNFTPacket(ctx sdk.Context, data types.BaseNFTPacket, packet channeltypes.Packet) error {
+func (k Keeper) OnRecvNFTPacket(ctx sdk.Context, data types.BaseNFTPacket, packet channeltypes.Packet) (types.BaseNFTPacket, error) {
 	
 	if nfts.GetContextOfCurrentChain() == nfts.FreeFlixContext && len(data.PrimaryNFTID) == 0 {
 		addr, err := sdk.AccAddressFromBech32(data.PrimaryNFTOwner)
 		if err != nil {
-			return err
+			return data, err
 		}
 		
-		_, err = k.bankKeeper.AddCoins(ctx, addr, sdk.Coins{data.LicensingFee})
+		// the owner minting through the licensee chain is the creator and goes through the checks of
+		// a mint on this chain, no royalty is set on the way
+		msg := nfts.NewMsgMintNFT(addr, "", data.AssetID, data.LicenseTerms, data.LicensingFee, data.RevenueShare, 0, 0,
+			sdk.ZeroDec(), nil, data.Platform, data.TwitterHandle, data.Metadata)
+		royaltyRate, err := k.nftKeeper.ValidateMint(ctx, msg)
 		if err != nil {
-			return err
+			return data, err
 		}
 		
-		count := k.nftKeeper.GetGlobalTweetCount(ctx)
-		primaryNFTID := nfts.GetPrimaryNFTID(count)
+		nft := k.nftKeeper.MintNewTweetNFT(ctx, msg, royaltyRate)
+		nft.SecondaryNFTID = data.SecondaryNFTID
+		nft.SecondaryOwner = data.SecondaryNFTOwner
+		k.nftKeeper.MintTweetNFT(ctx, nft)
+		
+		primaryNFTID := nft.PrimaryNFTID
 		data.PrimaryNFTID = primaryNFTID
+		if err := k.DistributeLicensingFee(ctx, nft, data.LicensingFee); err != nil {
+			return data, err
+		}
 		
-		k.nftKeeper.MintTweetNFT(ctx, *data.ToBaseTweetNFT())
-		k.SetTweetIDToAccount(ctx, addr, primaryNFTID)
-		k.SetGlobalTweetCount(ctx, count+1)
+		k.SetLicenseGrant(ctx, nfts.NewLicenseGrant(primaryNFTID, data.SecondaryNFTID, data.SecondaryNFTOwner,
+			packet.DestinationChannel, ctx.BlockHeight()))
 		
 		if err := k.XTransfer(ctx, packet.DestinationPort, packet.DestinationChannel, packet.TimeoutHeight, data.GetBytes()); err != nil {
-			return err
+			return data, err
 		}
 		
 	}
 	if nfts.GetContextOfCurrentChain() == nfts.CoCoContext && len(data.SecondaryNFTID) == 0 {
 		addr, err := sdk.AccAddressFromBech32(data.SecondaryNFTOwner)
 		if err != nil {
-			return err
+			return data, err
+		}
+		
+		if err := k.ValidateReceivedLicenseTerms(ctx, data, packet.SourceChannel); err != nil {
+			return data, err
 		}
 		
 		count := k.nftKeeper.GetGlobalTweetCount(ctx)
 		secondaryNFTID := nfts.GetSecondaryNFTID(count)
 		data.SecondaryNFTID = secondaryNFTID
 		
-		k.nftKeeper.MintTweetNFT(ctx, *data.ToBaseTweetNFT())
+		k.MirrorCollection(ctx, data.Collection)
+		
+		nft := data.ToBaseTweetNFT()
+		nft.ParentChannel = packet.DestinationChannel
+		if nft.ParentNFTID == "" {
+			nft.ParentNFTID = nft.PrimaryNFTID
+			nft.Licensors = []string{nft.PrimaryOwner}
+		}
+		
+		_, subscribed := k.GetSubscription(ctx, data.PrimaryNFTID, data.SecondaryNFTOwner)
+		subscribed = subscribed && !nft.IsSublicense()
+		nft.SetLicenseExpiry(ctx.BlockHeight(), ctx.BlockTime(), subscribed)
+		
+		k.nftKeeper.MintTweetNFT(ctx, *nft)
+		k.SetSecondaryNFTIndex(ctx, nft.PrimaryNFTID, nft.SecondaryNFTID)
+		if nft.HasLicenseExpiry() {
+			k.InsertLicenseExpiryQueue(ctx, types.NewExpiringLicense(nft.PrimaryNFTID, nft.SecondaryNFTID,
+				packet.DestinationPort, packet.DestinationChannel, nft.ExpiryHeight, nft.ExpiryTime))
+		}
+		
+		if !nft.IsSublicense() {
+			if err := k.ActivateSubscription(ctx, *nft, packet.DestinationPort, packet.DestinationChannel); err != nil {
+				return data, err
+			}
+		}
 		k.nftKeeper.SetTweetIDToAccount(ctx, addr, secondaryNFTID)
 		k.nftKeeper.SetGlobalTweetCount(ctx, count+1)
 		
+	} else if nfts.GetContextOfCurrentChain() == nfts.CoCoContext {
+		// a secondary nft created on this chain learns the id of the primary nft minted for it
+		if nft, found := k.GetTweetNFTByID(ctx, data.SecondaryNFTID); found && nft.PrimaryNFTID == "" {
+			nft.PrimaryNFTID = data.PrimaryNFTID
+			nft.ParentChannel = packet.DestinationChannel
+			k.MintTweetNFT(ctx, nft)
+			k.SetSecondaryNFTIndex(ctx, nft.PrimaryNFTID, nft.SecondaryNFTID)
+		}
 	}
 	
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -118,19 +192,79 @@ func (k Keeper) OnRecvNFTPacket(ctx sdk.Context, data types.BaseNFTPacket, packe
 			sdk.NewAttribute(nfts.AttributeSecondaryNFTID, data.SecondaryNFTID),
 		),
 	})
-	return nil
+	return data, nil
 }
 
-func (k Keeper) OnRecvXNFTTokenTransfer(ctx sdk.Context, data types.PacketPayLicensingFeeAndNFTTransfer) error {
+func (k Keeper) OnRecvXNFTTokenTransfer(ctx sdk.Context, data types.PacketPayLicensingFeeAndNFTTransfer, channel string) error {
 	
-	receiver, err := sdk.AccAddressFromBech32(data.Recipient)
-	if err != nil {
+	if _, err := sdk.AccAddressFromBech32(data.Recipient); err != nil {
+		return err
+	}
+	
+	nft, found := k.GetTweetNFTByID(ctx, data.PrimaryNFTID)
+	if !found {
+		return sdkerrors.Wrap(nfts.ErrNFTNotFound, data.PrimaryNFTID)
+	}
+	
+	if !nft.IsLicensable() {
+		return sdkerrors.Wrap(nfts.ErrInvalidLicense, fmt.Sprintf("unable to license %s", nft.PrimaryNFTID))
+	} else if !nft.LicenseTerms.IsChannelPermitted(channel) {
+		return sdkerrors.Wrapf(nfts.ErrInvalidLicense, "%s can not be licensed over %s", nft.PrimaryNFTID, channel)
+	} else if nft.PrimaryOwner != data.Recipient {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "recipient is not the primary owner")
+	} else if nft.IsCoOwned() {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is co-owned, its licenses are approved through proposals", nft.PrimaryNFTID)
+	} else if k.nftKeeper.IsEscrowed(ctx, nft.PrimaryNFTID) {
+		return sdkerrors.Wrap(nfts.ErrNFTEscrowed, nft.PrimaryNFTID)
+	}
+	
+	if data.SignedOffer != nil {
+		if err := k.ValidateSignedLicenseOffer(ctx, nft, data); err != nil {
+			return err
+		}
+	} else if !nft.LicensingFee.IsEqual(data.LicensingFee) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "licensing fee should be %s", nft.LicensingFee)
+	}
+	
+	if err := k.ValidateLicenseCap(ctx, nft); err != nil {
 		return err
 	}
 	
-	_, err = k.bankKeeper.AddCoins(ctx, receiver, sdk.Coins{data.LicensingFee})
+	if data.SignedOffer != nil {
+		terms := data.SignedOffer.Terms
+		k.SetSignedOfferRedemptions(ctx, terms.Owner, terms.Nonce, k.GetSignedOfferRedemptions(ctx, terms.Owner, terms.Nonce)+1)
+	}
+	
+	return k.DistributeLicensingFee(ctx, nft, data.LicensingFee)
+}
+
+func (k Keeper) OnAcknowledgementNFTPacket(ctx sdk.Context, data types.BaseNFTPacket, ack types.PostCreationPacketAcknowledgement,
+	packet channeltypes.Packet) {
+	licensee, found := k.GetPendingLicense(ctx, data.PrimaryNFTID, packet.SourceChannel, packet.Sequence)
+	if !found {
+		return
+	}
+	
+	k.DeletePendingLicense(ctx, data.PrimaryNFTID, packet.SourceChannel, packet.Sequence)
+	if !ack.Success {
+		return
+	}
+	
+	grant := nfts.NewLicenseGrant(data.PrimaryNFTID, ack.SecondaryNFTID, licensee, packet.SourceChannel, ctx.BlockHeight())
+	grant.Exclusive = data.LicenseTerms != nil && data.LicenseTerms.Exclusive
+	k.SetLicenseGrant(ctx, grant)
+}
+
+func (k Keeper) OnTimeoutNFTPacket(ctx sdk.Context, data types.BaseNFTPacket, packet channeltypes.Packet) {
+	k.DeletePendingLicense(ctx, data.PrimaryNFTID, packet.SourceChannel, packet.Sequence)
+}
+
+func (k Keeper) RefundLicensingFee(ctx sdk.Context, data types.PacketPayLicensingFeeAndNFTTransfer) error {
+	sender, err := sdk.AccAddressFromBech32(data.Sender)
 	if err != nil {
 		return err
 	}
-	return nil
+	
+	_, err = k.bankKeeper.AddCoins(ctx, sender, sdk.Coins{data.LicensingFee})
+	return err
 }
@@ -0,0 +1,41 @@
+package keeper_test
+
+import (
+	"testing"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	
+	"github.com/FreeFlixMedia/modules/internal/testutil"
+	"github.com/FreeFlixMedia/modules/nfts"
+	"github.com/FreeFlixMedia/modules/xnfts/internal/types"
+)
+
+func TestCoOwnedNFTIsOnlyLicensedThroughProposals(t *testing.T) {
+	ctx, k, nftKeeper, bank := setupKeeper(t, nfts.FreeFlixContext)
+	
+	owner, coOwner, licensee := testutil.NewAddr(), testutil.NewAddr(), testutil.NewAddr()
+	nft := mintNFT(t, ctx, nftKeeper, owner)
+	msg := nfts.MsgSetCoOwners{Sender: owner, PrimaryNFTID: nft.PrimaryNFTID, CoOwners: []sdk.AccAddress{owner, coOwner}, Threshold: 2}
+	if _, err := nftKeeper.SetCoOwners(ctx, msg); err != nil {
+		t.Fatal(err)
+	}
+	coOwned := nfts.CoOwnedAddress(nft.PrimaryNFTID)
+	
+	// paying the fee to the co-owned address does not stand in for an approved proposal
+	data := types.NewPacketPayLicensingFeeAndNFTTransfer(testutil.Coin(100), coOwned.String(), licensee.String(), nft.PrimaryNFTID)
+	if err := k.OnRecvXNFTTokenTransfer(ctx, data, channel); err == nil {
+		t.Fatal("a fee paid license of a co-owned nft should be refused")
+	}
+	testutil.RequireBalance(t, bank, owner, 0)
+	testutil.RequireBalance(t, bank, coOwner, 0)
+	
+	transfer := types.NewMsgXNFTTransfer(types.PortID, channel, 0, coOwned, types.NFTInput{PrimaryNFTID: nft.PrimaryNFTID, Recipient: licensee.String()})
+	if _, err := k.UpdateSecondaryNFTOwner(ctx, transfer); err == nil {
+		t.Fatal("the co-owned address should not license without an approved proposal")
+	}
+	
+	transfer.Sender = coOwner
+	if _, err := k.UpdateSecondaryNFTOwner(ctx, transfer); err == nil {
+		t.Fatal("a co-owner should not license without an approved proposal")
+	}
+}
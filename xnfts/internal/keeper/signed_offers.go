@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	
+	"github.com/FreeFlixMedia/modules/nfts"
+	"github.com/FreeFlixMedia/modules/xnfts/internal/types"
+)
+
+func (k Keeper) GetSignedOfferRedemptions(ctx sdk.Context, owner string, nonce uint64) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	
+	bz := store.Get(types.GetSignedOfferRedemptionKey(owner, nonce))
+	if bz == nil {
+		return 0
+	}
+	
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) SetSignedOfferRedemptions(ctx sdk.Context, owner string, nonce, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetSignedOfferRedemptionKey(owner, nonce), sdk.Uint64ToBigEndian(count))
+}
+
+// RedeemLicenseOffer pays the fee of a signed offer from the licensee chain, the offer travels
+// with the payment so the primary chain can verify it against the current owner.
+func (k Keeper) RedeemLicenseOffer(ctx sdk.Context, msg types.MsgRedeemLicenseOffer) (types.PacketPayLicensingFeeAndNFTTransfer, error) {
+	if msg.Offer.IsExpired(ctx.BlockTime()) {
+		return types.PacketPayLicensingFeeAndNFTTransfer{}, sdkerrors.Wrapf(types.ErrInvalidLicenseOffer, "offer %d has expired", msg.Offer.Terms.Nonce)
+	}
+	
+	packet, err := k.PayLicensingFeeAndNFTTransfer(ctx, msg.ToMsgPayLicensingFee())
+	if err != nil {
+		return types.PacketPayLicensingFeeAndNFTTransfer{}, err
+	}
+	
+	offer := msg.Offer
+	packet.SignedOffer = &offer
+	return packet, nil
+}
+
+func (k Keeper) ValidateSignedLicenseOffer(ctx sdk.Context, nft nfts.BaseTweetNFT, data types.PacketPayLicensingFeeAndNFTTransfer) error {
+	offer := data.SignedOffer
+	if err := offer.ValidateBasic(); err != nil {
+		return err
+	}
+	
+	if offer.IsExpired(ctx.BlockTime()) {
+		return sdkerrors.Wrapf(types.ErrInvalidLicenseOffer, "offer %d has expired", offer.Terms.Nonce)
+	} else if offer.Terms.ChainID != ctx.ChainID() {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "offer is signed for chain %s", offer.Terms.ChainID)
+	} else if offer.Terms.PrimaryNFTID != nft.PrimaryNFTID {
+		return sdkerrors.Wrapf(types.ErrInvalidLicenseOffer, "offer is made for %s", offer.Terms.PrimaryNFTID)
+	} else if offer.Terms.Owner != nft.PrimaryOwner || !offer.Signer().Equals(types.GetHexAddressFromBech32String(nft.PrimaryOwner)) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "offer is not signed by the primary owner")
+	} else if !offer.IsLicenseeAllowed(data.Sender) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "offer is made to another licensee")
+	} else if !offer.Terms.Fee.IsEqual(data.LicensingFee) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "licensing fee should be %s", offer.Terms.Fee)
+	}
+	
+	if k.IsSignedOfferRevoked(ctx, offer.Terms.Owner, offer.Terms.Nonce) {
+		return sdkerrors.Wrapf(types.ErrInvalidLicenseOffer, "offer %d is revoked", offer.Terms.Nonce)
+	}
+	
+	redemptions := k.GetSignedOfferRedemptions(ctx, offer.Terms.Owner, offer.Terms.Nonce)
+	if offer.Terms.MaxRedemptions > 0 && redemptions >= offer.Terms.MaxRedemptions {
+		return sdkerrors.Wrapf(types.ErrOfferFullyRedeemed, "offer %d was redeemed %d times", offer.Terms.Nonce, redemptions)
+	}
+	return nil
+}
+
+func (k Keeper) GetAllSignedOfferRedemptions(ctx sdk.Context) []types.SignedOfferRedemption {
+	store := ctx.KVStore(k.storeKey)
+	
+	iterator := sdk.KVStorePrefixIterator(store, types.SignedOfferRedemptionPrefix)
+	defer iterator.Close()
+	
+	var redemptions []types.SignedOfferRedemption
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()[len(types.SignedOfferRedemptionPrefix):]
+		redemptions = append(redemptions, types.SignedOfferRedemption{
+			Owner: string(key[:len(key)-9]),
+			Nonce: sdk.BigEndianToUint64(key[len(key)-8:]),
+			Count: sdk.BigEndianToUint64(iterator.Value()),
+		})
+	}
+	
+	return redemptions
+}
+
+func (k Keeper) SetSignedOfferRevoked(ctx sdk.Context, owner string, nonce uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetRevokedSignedOfferKey(owner, nonce), []byte{0x01})
+}
+
+func (k Keeper) IsSignedOfferRevoked(ctx sdk.Context, owner string, nonce uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetRevokedSignedOfferKey(owner, nonce))
+}
+
+// RevokeSignedOffer withdraws the offers the owner signed with the nonce before they expire.
+func (k Keeper) RevokeSignedOffer(ctx sdk.Context, msg types.MsgRevokeSignedOffer) error {
+	if nfts.GetContextOfCurrentChain() != nfts.FreeFlixContext {
+		return sdkerrors.Wrap(types.ErrInvalidLicenseOffer, "signed offers are revoked on the primary chain")
+	}
+	
+	owner := msg.Sender.String()
+	if k.IsSignedOfferRevoked(ctx, owner, msg.Nonce) {
+		return sdkerrors.Wrapf(types.ErrInvalidLicenseOffer, "offer %d is already revoked", msg.Nonce)
+	}
+	
+	k.SetSignedOfferRevoked(ctx, owner, msg.Nonce)
+	return nil
+}
+
+func (k Keeper) GetAllRevokedSignedOffers(ctx sdk.Context) []types.RevokedSignedOffer {
+	store := ctx.KVStore(k.storeKey)
+	
+	iterator := sdk.KVStorePrefixIterator(store, types.RevokedSignedOfferPrefix)
+	defer iterator.Close()
+	
+	var revoked []types.RevokedSignedOffer
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()[len(types.RevokedSignedOfferPrefix):]
+		revoked = append(revoked, types.RevokedSignedOffer{
+			Owner: string(key[:len(key)-9]),
+			Nonce: sdk.BigEndianToUint64(key[len(key)-8:]),
+		})
+	}
+	
+	return revoked
+}
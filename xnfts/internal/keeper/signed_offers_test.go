@@ -0,0 +1,70 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	
+	"github.com/FreeFlixMedia/modules/internal/testutil"
+	"github.com/FreeFlixMedia/modules/nfts"
+	"github.com/FreeFlixMedia/modules/xnfts/internal/types"
+)
+
+// signOffer signs an offer of the nft below its licensing fee that can be redeemed once.
+func signOffer(t *testing.T, ctx sdk.Context, key secp256k1.PrivKeySecp256k1, nft nfts.BaseTweetNFT, chainID string) types.SignedLicenseOffer {
+	terms := types.NewSignedOfferTerms(nft.PrimaryNFTID, nft.PrimaryOwner, "", testutil.Coin(40), 0, 0, 1, 1,
+		ctx.BlockTime().Add(time.Hour), chainID)
+	signature, err := key.Sign(terms.GetSignBytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return types.NewSignedLicenseOffer(terms, key.PubKey(), signature)
+}
+
+func TestRedeemSignedLicenseOffer(t *testing.T) {
+	ctx, k, nftKeeper, bank := setupKeeper(t, nfts.FreeFlixContext)
+	
+	key := secp256k1.GenPrivKey()
+	owner := sdk.AccAddress(key.PubKey().Address())
+	nft := mintNFT(t, ctx, nftKeeper, owner)
+	offer := signOffer(t, ctx, key, nft, ctx.ChainID())
+	
+	data := types.NewPacketPayLicensingFeeAndNFTTransfer(testutil.Coin(40), owner.String(), testutil.NewAddr().String(), nft.PrimaryNFTID)
+	data.SignedOffer = &offer
+	if err := k.OnRecvXNFTTokenTransfer(ctx, data, channel); err != nil {
+		t.Fatal(err)
+	}
+	testutil.RequireBalance(t, bank, owner, 40)
+	
+	if err := k.OnRecvXNFTTokenTransfer(ctx, data, channel); err == nil {
+		t.Fatal("an offer should not be redeemed more than its max redemptions")
+	}
+	testutil.RequireBalance(t, bank, owner, 40)
+}
+
+func TestRefuseRevokedAndForeignSignedLicenseOffers(t *testing.T) {
+	ctx, k, nftKeeper, bank := setupKeeper(t, nfts.FreeFlixContext)
+	
+	key := secp256k1.GenPrivKey()
+	owner := sdk.AccAddress(key.PubKey().Address())
+	nft := mintNFT(t, ctx, nftKeeper, owner)
+	
+	foreign := signOffer(t, ctx, key, nft, "other-chain")
+	data := types.NewPacketPayLicensingFeeAndNFTTransfer(testutil.Coin(40), owner.String(), testutil.NewAddr().String(), nft.PrimaryNFTID)
+	data.SignedOffer = &foreign
+	if err := k.OnRecvXNFTTokenTransfer(ctx, data, channel); err == nil {
+		t.Fatal("an offer signed for another chain should be refused")
+	}
+	
+	offer := signOffer(t, ctx, key, nft, ctx.ChainID())
+	data.SignedOffer = &offer
+	if err := k.RevokeSignedOffer(ctx, types.NewMsgRevokeSignedOffer(owner, offer.Terms.Nonce)); err != nil {
+		t.Fatal(err)
+	}
+	if err := k.OnRecvXNFTTokenTransfer(ctx, data, channel); err == nil {
+		t.Fatal("a revoked offer should be refused")
+	}
+	testutil.RequireBalance(t, bank, owner, 0)
+}
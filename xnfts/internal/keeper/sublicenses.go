@@ -0,0 +1,195 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	
+	"github.com/FreeFlixMedia/modules/nfts"
+	"github.com/FreeFlixMedia/modules/xnfts/internal/types"
+)
+
+// OfferSublicense opens a secondary nft whose license terms allow it to sublicensing, third
+// parties can then buy a sublicense for the fee and owe the share of their revenue to its owner.
+func (k Keeper) OfferSublicense(ctx sdk.Context, msg types.MsgOfferSublicense) (nfts.BaseTweetNFT, error) {
+	if nfts.GetContextOfCurrentChain() != nfts.CoCoContext {
+		return nfts.BaseTweetNFT{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "only secondary nfts can be sublicensed")
+	}
+	
+	nft, found := k.GetTweetNFTByID(ctx, msg.SecondaryNFTID)
+	if !found {
+		return nfts.BaseTweetNFT{}, sdkerrors.Wrap(nfts.ErrNFTNotFound, msg.SecondaryNFTID)
+	}
+	
+	if nft.SecondaryOwner != msg.Sender.String() {
+		return nfts.BaseTweetNFT{}, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only secondary owner can offer sublicenses")
+	} else if !nft.IsLicenseActive() || !nft.IsLicensable() || !nft.LicenseTerms.SublicensingAllowed {
+		return nfts.BaseTweetNFT{}, sdkerrors.Wrapf(nfts.ErrInvalidLicense, "license of %s does not allow sublicensing", nft.SecondaryNFTID)
+	}
+	
+	nft.SublicenseFee = msg.LicensingFee
+	nft.SublicenseShare = msg.RevenueShare
+	k.MintTweetNFT(ctx, nft)
+	return nft, nil
+}
+
+// BuySublicense grants a sublicense of a secondary nft held on this chain, the fee cascades up
+// the license chain of the parent nft.
+func (k Keeper) BuySublicense(ctx sdk.Context, sender sdk.AccAddress, parentNFTID string, fee sdk.Coin) (nfts.BaseTweetNFT, error) {
+	if nfts.GetContextOfCurrentChain() != nfts.CoCoContext {
+		return nfts.BaseTweetNFT{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "sublicenses can only be held on the licensee chain")
+	}
+	
+	parent, found := k.GetTweetNFTByID(ctx, parentNFTID)
+	if !found {
+		return nfts.BaseTweetNFT{}, sdkerrors.Wrap(nfts.ErrNFTNotFound, parentNFTID)
+	}
+	
+	if err := parent.ValidateSublicense(sender.String(), fee, k.nftKeeper.GetParams(ctx).MaxLicenseDepth); err != nil {
+		return nfts.BaseTweetNFT{}, err
+	}
+	
+	if _, err := k.SubtractCoins(ctx, sender, sdk.Coins{fee}); err != nil {
+		return nfts.BaseTweetNFT{}, err
+	}
+	
+	if err := k.CascadeRevenue(ctx, parent, fee); err != nil {
+		return nfts.BaseTweetNFT{}, err
+	}
+	
+	sublicense := parent.Sublicense(sender.String())
+	count := k.GetGlobalTweetCount(ctx)
+	sublicense.SecondaryNFTID = nfts.GetSecondaryNFTID(count)
+	sublicense.SetLicenseExpiry(ctx.BlockHeight(), ctx.BlockTime(), false)
+	
+	k.MintTweetNFT(ctx, sublicense)
+	k.SetSecondaryNFTIndex(ctx, sublicense.PrimaryNFTID, sublicense.SecondaryNFTID)
+	if sublicense.HasLicenseExpiry() {
+		k.InsertLicenseExpiryQueue(ctx, types.NewExpiringLicense(sublicense.PrimaryNFTID, sublicense.SecondaryNFTID,
+			"", "", sublicense.ExpiryHeight, sublicense.ExpiryTime))
+	}
+	k.SetTweetIDToAccount(ctx, sender, sublicense.SecondaryNFTID)
+	k.SetGlobalTweetCount(ctx, count+1)
+	return sublicense, nil
+}
+
+// PayForSublicense escrows the fee of a sublicense bought from a licensee on another chain.
+func (k Keeper) PayForSublicense(ctx sdk.Context, msg types.MsgBuySublicense) (types.PacketBuySublicense, error) {
+	if nfts.GetContextOfCurrentChain() != nfts.CoCoContext {
+		return types.PacketBuySublicense{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "sublicenses can only be held on the licensee chain")
+	}
+	
+	if _, err := k.SubtractCoins(ctx, msg.Sender, sdk.Coins{msg.LicensingFee}); err != nil {
+		return types.PacketBuySublicense{}, err
+	}
+	
+	return types.NewPacketBuySublicense(msg.ParentNFTID, msg.LicensingFee, msg.Sender.String()), nil
+}
+
+// OnRecvBuySublicense takes the fee of a sublicense bought from another chain and sends the
+// sublicense back to the buyer.
+func (k Keeper) OnRecvBuySublicense(ctx sdk.Context, data types.PacketBuySublicense, packet channeltypes.Packet) error {
+	if err := data.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	
+	parent, found := k.GetTweetNFTByID(ctx, data.ParentNFTID)
+	if !found {
+		return sdkerrors.Wrap(nfts.ErrNFTNotFound, data.ParentNFTID)
+	}
+	
+	if err := parent.ValidateSublicense(data.Sender, data.Fee, k.nftKeeper.GetParams(ctx).MaxLicenseDepth); err != nil {
+		return err
+	}
+	
+	if err := k.CascadeRevenue(ctx, parent, data.Fee); err != nil {
+		return err
+	}
+	
+	sublicense := parent.Sublicense(data.Sender)
+	nftPacket := types.NewBaseNFTPacket(sublicense.PrimaryNFTID, "", sublicense.PrimaryOwner, sublicense.SecondaryOwner,
+		sublicense.AssetID, sublicense.TwitterHandle, sublicense.LicenseTerms, sublicense.LicensingFee, sublicense.RevenueShare)
+	nftPacket.ParentNFTID = sublicense.ParentNFTID
+	nftPacket.Licensors = sublicense.Licensors
+	nftPacket.Metadata = sublicense.Metadata
+	nftPacket.Platform = sublicense.Platform
+	nftPacket.Collection = k.GetCollectionOfNFT(ctx, sublicense)
+	nftPacket.MasterNFTID = sublicense.MasterNFTID
+	nftPacket.EditionNumber = sublicense.EditionNumber
+	
+	return k.XTransfer(ctx, packet.DestinationPort, packet.DestinationChannel, packet.TimeoutHeight, nftPacket.GetBytes())
+}
+
+func (k Keeper) RefundSublicenseFee(ctx sdk.Context, data types.PacketBuySublicense) error {
+	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	if err != nil {
+		return err
+	}
+	
+	_, err = k.AddCoins(ctx, sender, sdk.Coins{data.Fee})
+	return err
+}
+
+// CascadeRevenue pays a revenue of the secondary nft to its owner and passes the revenue share
+// owed to its licensor up the license chain, across chains when the licensor lives on another one.
+// Licenses granted before sublicensing have no parent and keep their whole revenue.
+func (k Keeper) CascadeRevenue(ctx sdk.Context, nft nfts.BaseTweetNFT, amount sdk.Coin) error {
+	owner, err := sdk.AccAddressFromBech32(nft.SecondaryOwner)
+	if err != nil {
+		return err
+	}
+	
+	share := sdk.NewCoin(amount.Denom, sdk.ZeroInt())
+	if nft.ParentNFTID != "" && !nft.RevenueShare.IsNil() {
+		share.Amount = amount.Amount.ToDec().Mul(nft.RevenueShare).TruncateInt()
+	}
+	
+	if kept := amount.Sub(share); kept.IsPositive() {
+		if _, err := k.AddCoins(ctx, owner, sdk.Coins{kept}); err != nil {
+			return err
+		}
+	}
+	
+	if !share.IsPositive() {
+		return nil
+	}
+	
+	if nft.ParentChannel != "" {
+		packet := types.NewPacketSublicenseRevenue(nft.ParentNFTID, share, nft.SecondaryOwner)
+		return k.XTimedTransfer(ctx, k.GetPort(ctx), nft.ParentChannel, packet.GetBytes())
+	}
+	
+	parent, found := k.GetTweetNFTByID(ctx, nft.ParentNFTID)
+	if !found {
+		return sdkerrors.Wrap(nfts.ErrNFTNotFound, nft.ParentNFTID)
+	}
+	return k.CascadeRevenue(ctx, parent, share)
+}
+
+// OnRecvSublicenseRevenue pays a revenue share to the licensor it is owed to, the primary owner
+// takes it through the revenue splits of the primary nft.
+func (k Keeper) OnRecvSublicenseRevenue(ctx sdk.Context, data types.PacketSublicenseRevenue) error {
+	if err := data.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	
+	nft, found := k.GetTweetNFTByID(ctx, data.NFTID)
+	if !found {
+		return sdkerrors.Wrap(nfts.ErrNFTNotFound, data.NFTID)
+	}
+	
+	if nfts.GetContextOfCurrentChain() == nfts.FreeFlixContext {
+		return k.DistributeLicensingFee(ctx, nft, data.Amount)
+	}
+	return k.CascadeRevenue(ctx, nft, data.Amount)
+}
+
+func (k Keeper) RefundSublicenseRevenue(ctx sdk.Context, data types.PacketSublicenseRevenue) error {
+	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	if err != nil {
+		return err
+	}
+	
+	_, err = k.AddCoins(ctx, sender, sdk.Coins{data.Amount})
+	return err
+}
@@ -0,0 +1,85 @@
+package keeper_test
+
+import (
+	"testing"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	
+	"github.com/FreeFlixMedia/modules/internal/testutil"
+	"github.com/FreeFlixMedia/modules/nfts"
+	"github.com/FreeFlixMedia/modules/xnfts/internal/keeper"
+	"github.com/FreeFlixMedia/modules/xnfts/internal/types"
+)
+
+// sublicense offers a sublicense of the secondary nft for a fee of 100 and a revenue share of
+// 20%, and sells it to the buyer.
+func sublicense(t *testing.T, ctx sdk.Context, k keeper.Keeper, bank testutil.BankKeeper, parent nfts.BaseTweetNFT, buyer sdk.AccAddress) nfts.BaseTweetNFT {
+	owner, _ := sdk.AccAddressFromBech32(parent.SecondaryOwner)
+	if _, err := k.OfferSublicense(ctx, types.NewMsgOfferSublicense(owner, parent.SecondaryNFTID, testutil.Coin(100), sdk.NewDecWithPrec(2, 1))); err != nil {
+		t.Fatal(err)
+	}
+	
+	bank.SetBalance(buyer, 100)
+	nft, err := k.BuySublicense(ctx, buyer, parent.SecondaryNFTID, testutil.Coin(100))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return nft
+}
+
+func setupLicense(t *testing.T) (sdk.Context, keeper.Keeper, testutil.BankKeeper, nfts.BaseTweetNFT) {
+	ctx, k, nftKeeper, bank := setupKeeper(t, nfts.CoCoContext)
+	
+	terms := nfts.DefaultLicenseTerms()
+	terms.SublicensingAllowed = true
+	license := nfts.BaseTweetNFT{PrimaryNFTID: "primary", SecondaryNFTID: "secondary", PrimaryOwner: testutil.NewAddr().String(),
+		SecondaryOwner: testutil.NewAddr().String(), LicenseTerms: &terms, LicenseStatus: nfts.LicenseStatusActive}
+	license.Licensors = []string{license.PrimaryOwner}
+	nftKeeper.MintTweetNFT(ctx, license)
+	return ctx, k, bank, license
+}
+
+func TestSublicenseRevenueCascades(t *testing.T) {
+	ctx, k, bank, license := setupLicense(t)
+	
+	licensee, _ := sdk.AccAddressFromBech32(license.SecondaryOwner)
+	first, second := testutil.NewAddr(), testutil.NewAddr()
+	
+	// the license has no parent and keeps the whole fee
+	child := sublicense(t, ctx, k, bank, license, first)
+	testutil.RequireBalance(t, bank, licensee, 100)
+	
+	// the sublicense owes 20% of its fee to its licensor
+	sublicense(t, ctx, k, bank, child, second)
+	testutil.RequireBalance(t, bank, first, 80)
+	testutil.RequireBalance(t, bank, licensee, 120)
+}
+
+func TestSublicenseRejectsCyclesAndDepth(t *testing.T) {
+	ctx, k, bank, license := setupLicense(t)
+	
+	licensee, _ := sdk.AccAddressFromBech32(license.SecondaryOwner)
+	child := sublicense(t, ctx, k, bank, license, testutil.NewAddr())
+	
+	bank.SetBalance(licensee, 100)
+	if _, err := k.BuySublicense(ctx, licensee, child.SecondaryNFTID, testutil.Coin(100)); err == nil {
+		t.Fatal("a licensor should not buy a sublicense of its own license chain")
+	}
+	
+	// the default maximum depth is 3 licensors
+	grandchild := sublicense(t, ctx, k, bank, child, testutil.NewAddr())
+	if _, err := k.OfferSublicense(ctx, types.NewMsgOfferSublicense(sdk.AccAddress{}, grandchild.SecondaryNFTID, testutil.Coin(100), sdk.ZeroDec())); err == nil {
+		t.Fatal("only the secondary owner should offer sublicenses")
+	}
+	
+	owner, _ := sdk.AccAddressFromBech32(grandchild.SecondaryOwner)
+	if _, err := k.OfferSublicense(ctx, types.NewMsgOfferSublicense(owner, grandchild.SecondaryNFTID, testutil.Coin(100), sdk.ZeroDec())); err != nil {
+		t.Fatal(err)
+	}
+	buyer := testutil.NewAddr()
+	bank.SetBalance(buyer, 100)
+	if _, err := k.BuySublicense(ctx, buyer, grandchild.SecondaryNFTID, testutil.Coin(100)); err == nil {
+		t.Fatal("a sublicense deeper than the maximum license depth should be rejected")
+	}
+	testutil.RequireBalance(t, bank, buyer, 100)
+}
@@ -0,0 +1,306 @@
+package keeper
+
+import (
+	"fmt"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	
+	"github.com/FreeFlixMedia/modules/nfts"
+	"github.com/FreeFlixMedia/modules/xnfts/internal/types"
+)
+
+func (k Keeper) SetSubscription(ctx sdk.Context, subscription types.Subscription) {
+	store := ctx.KVStore(k.storeKey)
+	
+	key := types.GetSubscriptionKey(subscription.PrimaryNFTID, subscription.Subscriber)
+	store.Set(key, k.cdc.MustMarshalBinaryLengthPrefixed(subscription))
+}
+
+func (k Keeper) GetSubscription(ctx sdk.Context, primaryNFTID, subscriber string) (types.Subscription, bool) {
+	store := ctx.KVStore(k.storeKey)
+	
+	bz := store.Get(types.GetSubscriptionKey(primaryNFTID, subscriber))
+	if bz == nil {
+		return types.Subscription{}, false
+	}
+	
+	var subscription types.Subscription
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &subscription)
+	return subscription, true
+}
+
+func (k Keeper) DeleteSubscription(ctx sdk.Context, subscription types.Subscription) {
+	store := ctx.KVStore(k.storeKey)
+	
+	store.Delete(types.GetSubscriptionKey(subscription.PrimaryNFTID, subscription.Subscriber))
+	if subscription.IsActive() {
+		store.Delete(types.GetSubscriptionQueueKey(subscription.NextPaymentHeight, subscription.PrimaryNFTID, subscription.Subscriber))
+	}
+}
+
+func (k Keeper) GetAllSubscriptions(ctx sdk.Context) []types.Subscription {
+	store := ctx.KVStore(k.storeKey)
+	
+	iterator := sdk.KVStorePrefixIterator(store, types.SubscriptionPrefix)
+	defer iterator.Close()
+	
+	var subscriptions []types.Subscription
+	for ; iterator.Valid(); iterator.Next() {
+		var subscription types.Subscription
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &subscription)
+		subscriptions = append(subscriptions, subscription)
+	}
+	
+	return subscriptions
+}
+
+func (k Keeper) InsertSubscriptionQueue(ctx sdk.Context, subscription types.Subscription) {
+	store := ctx.KVStore(k.storeKey)
+	
+	key := types.GetSubscriptionQueueKey(subscription.NextPaymentHeight, subscription.PrimaryNFTID, subscription.Subscriber)
+	store.Set(key, []byte(subscription.Subscriber))
+}
+
+// DequeueDueSubscriptions removes and returns every active subscription whose payment is due.
+func (k Keeper) DequeueDueSubscriptions(ctx sdk.Context) []types.Subscription {
+	store := ctx.KVStore(k.storeKey)
+	
+	iterator := store.Iterator(types.SubscriptionQueuePrefix,
+		sdk.PrefixEndBytes(types.GetSubscriptionQueueHeightKey(ctx.BlockHeight())))
+	
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	
+	var subscriptions []types.Subscription
+	for _, key := range keys {
+		store.Delete(key)
+		
+		subscriptionKey := append(types.SubscriptionPrefix, key[len(types.SubscriptionQueuePrefix)+8:]...)
+		bz := store.Get(subscriptionKey)
+		if bz == nil {
+			continue
+		}
+		
+		var subscription types.Subscription
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &subscription)
+		subscriptions = append(subscriptions, subscription)
+	}
+	
+	return subscriptions
+}
+
+func (k Keeper) SubscribeLicense(ctx sdk.Context, msg types.MsgSubscribeLicense) (types.PacketPayLicensingFeeAndNFTTransfer, error) {
+	if _, found := k.GetSubscription(ctx, msg.PrimaryNFTID, msg.Sender.String()); found {
+		return types.PacketPayLicensingFeeAndNFTTransfer{}, sdkerrors.Wrap(types.ErrSubscriptionAlreadyExist, msg.PrimaryNFTID)
+	}
+	
+	packet, err := k.PayLicensingFeeAndNFTTransfer(ctx, msg.ToMsgPayLicensingFee())
+	if err != nil {
+		return types.PacketPayLicensingFeeAndNFTTransfer{}, err
+	}
+	
+	if _, err := k.SubtractCoins(ctx, msg.Sender, sdk.Coins{msg.Deposit}); err != nil {
+		return types.PacketPayLicensingFeeAndNFTTransfer{}, err
+	}
+	
+	k.SetSubscription(ctx, types.NewSubscription(msg.PrimaryNFTID, msg.Sender.String(), msg.Recipient, msg.LicensingFee, msg.Deposit))
+	return packet, nil
+}
+
+func (k Keeper) TopUpSubscription(ctx sdk.Context, msg types.MsgTopUpSubscription) error {
+	subscription, found := k.GetSubscription(ctx, msg.PrimaryNFTID, msg.Sender.String())
+	if !found {
+		return sdkerrors.Wrap(types.ErrSubscriptionNotFound, msg.PrimaryNFTID)
+	}
+	
+	if msg.Amount.Denom != subscription.Deposit.Denom {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "deposit should be in %s", subscription.Deposit.Denom)
+	}
+	
+	if _, err := k.SubtractCoins(ctx, msg.Sender, sdk.Coins{msg.Amount}); err != nil {
+		return err
+	}
+	
+	subscription.Deposit = subscription.Deposit.Add(msg.Amount)
+	k.SetSubscription(ctx, subscription)
+	return nil
+}
+
+func (k Keeper) CancelSubscription(ctx sdk.Context, msg types.MsgCancelSubscription) error {
+	subscription, found := k.GetSubscription(ctx, msg.PrimaryNFTID, msg.Sender.String())
+	if !found {
+		return sdkerrors.Wrap(types.ErrSubscriptionNotFound, msg.PrimaryNFTID)
+	}
+	
+	return k.EndSubscription(ctx, subscription)
+}
+
+// EndSubscription refunds the remaining deposit and expires the license held through the subscription.
+func (k Keeper) EndSubscription(ctx sdk.Context, subscription types.Subscription) error {
+	k.DeleteSubscription(ctx, subscription)
+	
+	if !subscription.Deposit.IsZero() {
+		subscriber, err := sdk.AccAddressFromBech32(subscription.Subscriber)
+		if err != nil {
+			return err
+		}
+		
+		if _, err := k.AddCoins(ctx, subscriber, sdk.Coins{subscription.Deposit}); err != nil {
+			return err
+		}
+	}
+	
+	if subscription.IsActive() {
+		k.ExpireLicense(ctx, types.NewExpiringLicense(subscription.PrimaryNFTID, subscription.SecondaryNFTID,
+			subscription.Port, subscription.Channel, 0, ctx.BlockTime()))
+	}
+	return nil
+}
+
+// ActivateSubscription starts the pending subscription of the licensee once its secondary nft is minted.
+func (k Keeper) ActivateSubscription(ctx sdk.Context, nft nfts.BaseTweetNFT, port, channel string) error {
+	subscription, found := k.GetSubscription(ctx, nft.PrimaryNFTID, nft.SecondaryOwner)
+	if !found || subscription.IsActive() {
+		return nil
+	}
+	
+	if nft.LicenseTerms == nil || !nft.LicenseTerms.IsSubscription() {
+		return k.EndSubscription(ctx, subscription)
+	}
+	
+	subscription.SecondaryNFTID = nft.SecondaryNFTID
+	subscription.PeriodBlocks = nft.LicenseTerms.SubscriptionPeriodBlocks
+	subscription.NextPaymentHeight = ctx.BlockHeight() + subscription.PeriodBlocks
+	subscription.Port = port
+	subscription.Channel = channel
+	
+	k.SetSubscription(ctx, subscription)
+	k.InsertSubscriptionQueue(ctx, subscription)
+	return nil
+}
+
+// CollectSubscriptionPayment pays a period out of the subscription deposit to the primary owner,
+// the subscription lapses once the deposit can not cover the fee.
+func (k Keeper) CollectSubscriptionPayment(ctx sdk.Context, subscription types.Subscription) error {
+	nft, found := k.GetTweetNFTByID(ctx, subscription.SecondaryNFTID)
+	if !found || !nft.IsLicenseActive() || subscription.Deposit.IsLT(subscription.Fee) {
+		return k.lapseSubscription(ctx, subscription)
+	}
+	
+	packet := types.NewPacketSubscriptionPayment(subscription.PrimaryNFTID, subscription.SecondaryNFTID,
+		subscription.Fee, subscription.Subscriber, subscription.Recipient)
+	if err := k.XTimedTransfer(ctx, subscription.Port, subscription.Channel, packet.GetBytes()); err != nil {
+		return err
+	}
+	
+	subscription.Deposit = subscription.Deposit.Sub(subscription.Fee)
+	subscription.NextPaymentHeight += subscription.PeriodBlocks
+	k.SetSubscription(ctx, subscription)
+	k.InsertSubscriptionQueue(ctx, subscription)
+	
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSubscriptionPayment,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyPrimaryNFTID, subscription.PrimaryNFTID),
+			sdk.NewAttribute(types.AttributeKeyReceiver, subscription.Recipient),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, subscription.Fee.String()),
+		),
+	)
+	return nil
+}
+
+func (k Keeper) lapseSubscription(ctx sdk.Context, subscription types.Subscription) error {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSubscriptionLapsed,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyPrimaryNFTID, subscription.PrimaryNFTID),
+			sdk.NewAttribute(types.AttributeKeySecondaryNFTID, subscription.SecondaryNFTID),
+		),
+	)
+	return k.EndSubscription(ctx, subscription)
+}
+
+// CollectSubscriptionPayments collects every due subscription on its own, a failed collection
+// leaves no state behind and is tried again next period if the subscription is still active.
+func (k Keeper) CollectSubscriptionPayments(ctx sdk.Context) {
+	for _, subscription := range k.DequeueDueSubscriptions(ctx) {
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.CollectSubscriptionPayment(cacheCtx, subscription); err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("failed to collect subscription of %s: %s", subscription.SecondaryNFTID, err.Error()))
+			
+			subscription, found := k.GetSubscription(ctx, subscription.PrimaryNFTID, subscription.Subscriber)
+			if found && subscription.IsActive() {
+				subscription.NextPaymentHeight += subscription.PeriodBlocks
+				k.SetSubscription(ctx, subscription)
+				k.InsertSubscriptionQueue(ctx, subscription)
+			}
+			continue
+		}
+		
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+}
+
+// OnRecvSubscriptionPayment pays the subscription fee to whoever owns the primary nft when it arrives.
+func (k Keeper) OnRecvSubscriptionPayment(ctx sdk.Context, data types.PacketSubscriptionPayment, packet channeltypes.Packet) error {
+	grant, found := k.nftKeeper.GetLicenseGrant(ctx, data.PrimaryNFTID, packet.DestinationChannel, data.SecondaryNFTID)
+	if !found || !grant.IsActive() {
+		return sdkerrors.Wrapf(nfts.ErrInvalidLicense, "license %s of %s is not active", data.SecondaryNFTID, data.PrimaryNFTID)
+	}
+	
+	nft, found := k.GetTweetNFTByID(ctx, data.PrimaryNFTID)
+	if !found {
+		return sdkerrors.Wrap(nfts.ErrNFTNotFound, data.PrimaryNFTID)
+	}
+	
+	return k.DistributeLicensingFee(ctx, nft, data.Fee)
+}
+
+// LapseRejectedSubscription ends the subscription whose payment the primary chain rejected, the
+// rejected fee is returned along with the deposit and the license held through it expires.
+func (k Keeper) LapseRejectedSubscription(ctx sdk.Context, data types.PacketSubscriptionPayment) error {
+	subscription, found := k.GetSubscription(ctx, data.PrimaryNFTID, data.Sender)
+	if !found || subscription.SecondaryNFTID != data.SecondaryNFTID {
+		return k.RefundSubscriptionPayment(ctx, data)
+	}
+	
+	subscription.Deposit = subscription.Deposit.Add(data.Fee)
+	return k.lapseSubscription(ctx, subscription)
+}
+
+// RefundSubscriptionPayment returns an unaccepted payment to the subscription deposit, or to
+// the subscriber once the subscription has ended.
+func (k Keeper) RefundSubscriptionPayment(ctx sdk.Context, data types.PacketSubscriptionPayment) error {
+	subscription, found := k.GetSubscription(ctx, data.PrimaryNFTID, data.Sender)
+	if found {
+		subscription.Deposit = subscription.Deposit.Add(data.Fee)
+		k.SetSubscription(ctx, subscription)
+		return nil
+	}
+	
+	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	if err != nil {
+		return err
+	}
+	
+	_, err = k.AddCoins(ctx, sender, sdk.Coins{data.Fee})
+	return err
+}
+
+// RefundPendingSubscription returns the deposit of a subscription whose first payment failed.
+func (k Keeper) RefundPendingSubscription(ctx sdk.Context, data types.PacketPayLicensingFeeAndNFTTransfer) error {
+	subscription, found := k.GetSubscription(ctx, data.PrimaryNFTID, data.Sender)
+	if !found || subscription.IsActive() {
+		return nil
+	}
+	
+	return k.EndSubscription(ctx, subscription)
+}
@@ -0,0 +1,46 @@
+package keeper_test
+
+import (
+	"testing"
+	
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	
+	"github.com/FreeFlixMedia/modules/internal/testutil"
+	"github.com/FreeFlixMedia/modules/nfts"
+	"github.com/FreeFlixMedia/modules/xnfts/internal/types"
+)
+
+func TestSubscriptionPaymentPaysCurrentOwner(t *testing.T) {
+	ctx, k, nftKeeper, bank := setupKeeper(t, nfts.FreeFlixContext)
+	
+	seller, buyer, subscriber := testutil.NewAddr(), testutil.NewAddr(), testutil.NewAddr()
+	nft := mintNFT(t, ctx, nftKeeper, seller)
+	nftKeeper.SetLicenseGrant(ctx, nfts.NewLicenseGrant(nft.PrimaryNFTID, "secondary", subscriber.String(), channel, ctx.BlockHeight()))
+	nftKeeper.TransferTweetNFT(ctx, nft, seller, buyer)
+	
+	// the recipient was frozen when the licensee subscribed, before the nft changed hands
+	data := types.NewPacketSubscriptionPayment(nft.PrimaryNFTID, "secondary", testutil.Coin(100), subscriber.String(), seller.String())
+	if err := k.OnRecvSubscriptionPayment(ctx, data, channeltypes.Packet{DestinationChannel: channel}); err != nil {
+		t.Fatal(err)
+	}
+	testutil.RequireBalance(t, bank, seller, 0)
+	testutil.RequireBalance(t, bank, buyer, 100)
+}
+
+func TestRejectedSubscriptionPaymentLapses(t *testing.T) {
+	ctx, k, _, bank := setupKeeper(t, nfts.CoCoContext)
+	
+	subscriber := testutil.NewAddr()
+	subscription := types.NewSubscription("primary", subscriber.String(), testutil.NewAddr().String(), testutil.Coin(100), testutil.Coin(200))
+	subscription.SecondaryNFTID = "secondary"
+	k.SetSubscription(ctx, subscription)
+	
+	data := types.NewPacketSubscriptionPayment("primary", "secondary", testutil.Coin(100), subscriber.String(), subscription.Recipient)
+	if err := k.LapseRejectedSubscription(ctx, data); err != nil {
+		t.Fatal(err)
+	}
+	if _, found := k.GetSubscription(ctx, "primary", subscriber.String()); found {
+		t.Fatal("the subscription should lapse once a payment is rejected")
+	}
+	testutil.RequireBalance(t, bank, subscriber, 300)
+}
@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	
+	"github.com/FreeFlixMedia/modules/nfts"
+	"github.com/FreeFlixMedia/modules/xnfts/internal/types"
+)
+
+// TransferSecondaryNFT hands an active license over to the recipient, the primary chain is
+// notified of the new licensee of the licenses it granted.
+func (k Keeper) TransferSecondaryNFT(ctx sdk.Context, msg types.MsgTransferSecondaryNFT) (nfts.BaseTweetNFT, error) {
+	if nfts.GetContextOfCurrentChain() != nfts.CoCoContext {
+		return nfts.BaseTweetNFT{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "only secondary nfts can be transferred on the licensee chain")
+	}
+	
+	nft, found := k.GetTweetNFTByID(ctx, msg.SecondaryNFTID)
+	if !found {
+		return nfts.BaseTweetNFT{}, sdkerrors.Wrap(nfts.ErrNFTNotFound, msg.SecondaryNFTID)
+	}
+	
+	if nft.SecondaryOwner != msg.Sender.String() {
+		return nfts.BaseTweetNFT{}, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only secondary owner can transfer nft")
+	} else if !nft.IsLicenseActive() {
+		return nfts.BaseTweetNFT{}, sdkerrors.Wrapf(nfts.ErrInvalidLicense, "license of %s is not active", nft.SecondaryNFTID)
+	} else if nft.IsInLicenseChain(msg.Recipient.String()) {
+		return nfts.BaseTweetNFT{}, sdkerrors.Wrapf(nfts.ErrInvalidLicense, "%s already licenses %s", msg.Recipient, nft.SecondaryNFTID)
+	}
+	
+	if subscription, found := k.GetSubscription(ctx, nft.PrimaryNFTID, nft.SecondaryOwner); found && subscription.IsActive() {
+		return nfts.BaseTweetNFT{}, sdkerrors.Wrapf(nfts.ErrInvalidLicense, "subscription to %s should be cancelled before transfer", nft.PrimaryNFTID)
+	}
+	
+	for _, _nft := range k.nftKeeper.GetTweetsOfAccount(ctx, msg.Recipient) {
+		if _nft.PrimaryNFTID == nft.PrimaryNFTID && _nft.IsLicenseActive() {
+			return nfts.BaseTweetNFT{}, sdkerrors.Wrap(nfts.ErrInvalidLicense, "primary nfts already licensed to recipient")
+		}
+	}
+	
+	nft = k.changeSecondaryOwner(ctx, nft, msg.Sender, msg.Recipient)
+	
+	// only licenses granted by the primary owner are tracked on the primary chain
+	if !nft.IsSublicense() && nft.ParentChannel != "" {
+		packet := types.NewPacketLicenseTransferred(nft.PrimaryNFTID, nft.SecondaryNFTID, msg.Sender.String(), msg.Recipient.String())
+		if err := k.XTimedTransfer(ctx, k.GetPort(ctx), nft.ParentChannel, packet.GetBytes()); err != nil {
+			return nfts.BaseTweetNFT{}, err
+		}
+	}
+	
+	return nft, nil
+}
+
+func (k Keeper) changeSecondaryOwner(ctx sdk.Context, nft nfts.BaseTweetNFT, from, to sdk.AccAddress) nfts.BaseTweetNFT {
+	k.nftKeeper.RemoveTweetIDFromAccount(ctx, from, nft.SecondaryNFTID)
+	k.SetTweetIDToAccount(ctx, to, nft.SecondaryNFTID)
+	
+	nft.SecondaryOwner = to.String()
+	k.MintTweetNFT(ctx, nft)
+	return nft
+}
+
+// OnRecvLicenseTransferred records the new licensee of a license granted by the primary chain.
+func (k Keeper) OnRecvLicenseTransferred(ctx sdk.Context, data types.PacketLicenseTransferred, packet channeltypes.Packet) error {
+	if err := data.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	
+	grant, found := k.nftKeeper.GetLicenseGrant(ctx, data.PrimaryNFTID, packet.DestinationChannel, data.SecondaryNFTID)
+	if !found {
+		return sdkerrors.Wrapf(nfts.ErrInvalidLicense, "license %s of %s not found", data.SecondaryNFTID, data.PrimaryNFTID)
+	}
+	
+	if grant.Licensee != data.Sender {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "license %s is not held by %s", data.SecondaryNFTID, data.Sender)
+	}
+	
+	grant.Licensee = data.Recipient
+	k.SetLicenseGrant(ctx, grant)
+	return nil
+}
+
+// RevertLicenseTransfer gives the license back to its former owner when the primary chain did
+// not take the transfer, unless the recipient already passed it on.
+func (k Keeper) RevertLicenseTransfer(ctx sdk.Context, data types.PacketLicenseTransferred) {
+	nft, found := k.GetTweetNFTByID(ctx, data.SecondaryNFTID)
+	if !found || nft.SecondaryOwner != data.Recipient {
+		return
+	}
+	
+	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	if err != nil {
+		return
+	}
+	
+	recipient, err := sdk.AccAddressFromBech32(data.Recipient)
+	if err != nil {
+		return
+	}
+	
+	k.changeSecondaryOwner(ctx, nft, recipient, sender)
+}
@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	"testing"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	
+	"github.com/FreeFlixMedia/modules/internal/testutil"
+	"github.com/FreeFlixMedia/modules/nfts"
+	"github.com/FreeFlixMedia/modules/xnfts/internal/types"
+)
+
+func TestTransferSecondaryNFT(t *testing.T) {
+	ctx, k, nftKeeper, _ := setupKeeper(t, nfts.CoCoContext)
+	
+	licensee, recipient := testutil.NewAddr(), testutil.NewAddr()
+	terms := nfts.DefaultLicenseTerms()
+	license := nfts.BaseTweetNFT{PrimaryNFTID: "primary", SecondaryNFTID: "secondary", PrimaryOwner: testutil.NewAddr().String(),
+		SecondaryOwner: licensee.String(), LicenseTerms: &terms, LicenseStatus: nfts.LicenseStatusActive}
+	license.Licensors = []string{license.PrimaryOwner}
+	nftKeeper.MintTweetNFT(ctx, license)
+	k.SetTweetIDToAccount(ctx, licensee, license.SecondaryNFTID)
+	
+	primaryOwner, _ := sdk.AccAddressFromBech32(license.PrimaryOwner)
+	if _, err := k.TransferSecondaryNFT(ctx, types.NewMsgTransferSecondaryNFT(licensee, license.SecondaryNFTID, primaryOwner)); err == nil {
+		t.Fatal("a license should not be transferred back to its licensor")
+	}
+	
+	subscription := types.NewSubscription(license.PrimaryNFTID, licensee.String(), license.PrimaryOwner, testutil.Coin(100), testutil.Coin(200))
+	subscription.SecondaryNFTID = license.SecondaryNFTID
+	k.SetSubscription(ctx, subscription)
+	if _, err := k.TransferSecondaryNFT(ctx, types.NewMsgTransferSecondaryNFT(licensee, license.SecondaryNFTID, recipient)); err == nil {
+		t.Fatal("a subscribed license should not be transferred")
+	}
+	k.DeleteSubscription(ctx, subscription)
+	
+	if _, err := k.TransferSecondaryNFT(ctx, types.NewMsgTransferSecondaryNFT(licensee, license.SecondaryNFTID, recipient)); err != nil {
+		t.Fatal(err)
+	}
+	if nft, _ := k.GetTweetNFTByID(ctx, license.SecondaryNFTID); nft.SecondaryOwner != recipient.String() {
+		t.Fatalf("the license should be held by the recipient, got %s", nft.SecondaryOwner)
+	}
+	if len(nftKeeper.GetTweetsOfAccount(ctx, licensee)) != 0 || len(nftKeeper.GetTweetsOfAccount(ctx, recipient)) != 1 {
+		t.Fatal("the license should move to the account of the recipient")
+	}
+}
+
+func TestLicenseTransferredUpdatesGrant(t *testing.T) {
+	ctx, k, nftKeeper, _ := setupKeeper(t, nfts.FreeFlixContext)
+	
+	licensee, recipient := testutil.NewAddr(), testutil.NewAddr()
+	nft := mintNFT(t, ctx, nftKeeper, testutil.NewAddr())
+	nftKeeper.SetLicenseGrant(ctx, nfts.NewLicenseGrant(nft.PrimaryNFTID, "secondary", licensee.String(), channel, ctx.BlockHeight()))
+	
+	packet := channeltypes.Packet{DestinationChannel: channel}
+	data := types.NewPacketLicenseTransferred(nft.PrimaryNFTID, "secondary", recipient.String(), licensee.String())
+	if err := k.OnRecvLicenseTransferred(ctx, data, packet); err == nil {
+		t.Fatal("only the licensee of the grant should transfer it")
+	}
+	
+	data = types.NewPacketLicenseTransferred(nft.PrimaryNFTID, "secondary", licensee.String(), recipient.String())
+	if err := k.OnRecvLicenseTransferred(ctx, data, packet); err != nil {
+		t.Fatal(err)
+	}
+	if grant, _ := nftKeeper.GetLicenseGrant(ctx, nft.PrimaryNFTID, channel, "secondary"); grant.Licensee != recipient.String() {
+		t.Fatalf("the grant should name the recipient, got %s", grant.Licensee)
+	}
+}
@@ -17,9 +17,32 @@ var (
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgXNFTTransfer{}, "ibc/xnfts/MsgXNFTTransfer", nil)
 	cdc.RegisterConcrete(MsgPayLicensingFee{}, "ibc/xnft/MsgPayLicensingFee", nil)
+	cdc.RegisterConcrete(MsgSubscribeLicense{}, "ibc/xnft/MsgSubscribeLicense", nil)
+	cdc.RegisterConcrete(MsgTopUpSubscription{}, "ibc/xnft/MsgTopUpSubscription", nil)
+	cdc.RegisterConcrete(MsgCancelSubscription{}, "ibc/xnft/MsgCancelSubscription", nil)
+	cdc.RegisterConcrete(MsgMakeLicenseOffer{}, "ibc/xnft/MsgMakeLicenseOffer", nil)
+	cdc.RegisterConcrete(MsgAcceptLicenseOffer{}, "ibc/xnft/MsgAcceptLicenseOffer", nil)
+	cdc.RegisterConcrete(MsgRejectLicenseOffer{}, "ibc/xnft/MsgRejectLicenseOffer", nil)
+	cdc.RegisterConcrete(MsgRedeemLicenseOffer{}, "ibc/xnft/MsgRedeemLicenseOffer", nil)
+	cdc.RegisterConcrete(MsgRevokeSignedOffer{}, "ibc/xnft/MsgRevokeSignedOffer", nil)
+	cdc.RegisterConcrete(MsgOfferSublicense{}, "ibc/xnft/MsgOfferSublicense", nil)
+	cdc.RegisterConcrete(MsgBuySublicense{}, "ibc/xnft/MsgBuySublicense", nil)
+	cdc.RegisterConcrete(MsgTransferSecondaryNFT{}, "ibc/xnft/MsgTransferSecondaryNFT", nil)
+	cdc.RegisterConcrete(MsgXNFTBatchTransfer{}, "ibc/xnft/MsgXNFTBatchTransfer", nil)
+	cdc.RegisterConcrete(MsgPayBundleLicensingFee{}, "ibc/xnft/MsgPayBundleLicensingFee", nil)
+	cdc.RegisterConcrete(MsgRedeemMintVoucher{}, "ibc/xnft/MsgRedeemMintVoucher", nil)
 	
 	cdc.RegisterConcrete(BaseNFTPacket{}, "ibc/xnfts/BaseNFTPacket", nil)
 	cdc.RegisterConcrete(PacketPayLicensingFeeAndNFTTransfer{}, "ibc/xnft/PacketPayLicensingFeeAndNFTTransfer", nil)
+	cdc.RegisterConcrete(PacketLicenseExpired{}, "ibc/xnft/PacketLicenseExpired", nil)
+	cdc.RegisterConcrete(PacketSubscriptionPayment{}, "ibc/xnft/PacketSubscriptionPayment", nil)
+	cdc.RegisterConcrete(PacketLicenseOffer{}, "ibc/xnft/PacketLicenseOffer", nil)
+	cdc.RegisterConcrete(PacketLicenseOfferResponse{}, "ibc/xnft/PacketLicenseOfferResponse", nil)
+	cdc.RegisterConcrete(PacketBuySublicense{}, "ibc/xnft/PacketBuySublicense", nil)
+	cdc.RegisterConcrete(PacketSublicenseRevenue{}, "ibc/xnft/PacketSublicenseRevenue", nil)
+	cdc.RegisterConcrete(PacketLicenseTransferred{}, "ibc/xnft/PacketLicenseTransferred", nil)
+	cdc.RegisterConcrete(PacketBatchNFTTransfer{}, "ibc/xnft/PacketBatchNFTTransfer", nil)
+	cdc.RegisterConcrete(PacketPayBundleLicensingFee{}, "ibc/xnft/PacketPayBundleLicensingFee", nil)
 	
 	cdc.RegisterInterface((*XNFTs)(nil), nil)
 }
@@ -30,6 +53,7 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 
 func init() {
 	RegisterCodec(amino)
+	codec.RegisterCrypto(amino)
 	channel.RegisterCodec(amino)
 	commitmenttypes.RegisterCodec(amino)
 	amino.Seal()
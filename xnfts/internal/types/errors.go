@@ -0,0 +1,12 @@
+package types
+
+import sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+var (
+	ErrSubscriptionNotFound     = sdkerrors.Register(ModuleName, 11, "subscription not found")
+	ErrSubscriptionAlreadyExist = sdkerrors.Register(ModuleName, 12, "subscription already exist")
+	ErrLicenseOfferNotFound     = sdkerrors.Register(ModuleName, 13, "license offer not found")
+	ErrInvalidLicenseOffer      = sdkerrors.Register(ModuleName, 14, "invalid license offer")
+	ErrOfferFullyRedeemed       = sdkerrors.Register(ModuleName, 15, "license offer fully redeemed")
+	ErrInvalidMintVoucher       = sdkerrors.Register(ModuleName, 16, "invalid mint voucher")
+)
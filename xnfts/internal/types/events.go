@@ -9,7 +9,38 @@ import (
 var (
 	EventTypeNFTPacketTransfer             = "nft_packet_transfer"
 	EventTypePayLicensingFeeAndNFTTransfer = "pay_licensing_fee_and_token_transfer"
+	EventTypeLicenseExpired                = "license_expired"
+	EventTypeSubscribeLicense              = "subscribe_license"
+	EventTypeTopUpSubscription             = "top_up_subscription"
+	EventTypeCancelSubscription            = "cancel_subscription"
+	EventTypeSubscriptionPayment           = "subscription_payment"
+	EventTypeSubscriptionLapsed            = "subscription_lapsed"
+	EventTypeMakeLicenseOffer              = "make_license_offer"
+	EventTypeLicenseOfferReceived          = "license_offer_received"
+	EventTypeAcceptLicenseOffer            = "accept_license_offer"
+	EventTypeRejectLicenseOffer            = "reject_license_offer"
+	EventTypeLicenseOfferResponse          = "license_offer_response"
+	EventTypeLicenseOfferExpired           = "license_offer_expired"
+	EventTypeRedeemLicenseOffer            = "redeem_license_offer"
+	EventTypeRevokeSignedOffer             = "revoke_signed_offer"
+	EventTypeOfferSublicense               = "offer_sublicense"
+	EventTypeBuySublicense                 = "buy_sublicense"
+	EventTypeSublicenseRevenue             = "sublicense_revenue"
+	EventTypeTransferSecondaryNFT          = "transfer_secondary_nft"
+	EventTypeLicenseTransferred            = "license_transferred"
+	EventTypeNFTBatchTransfer              = "nft_batch_transfer"
+	EventTypePayBundleLicensingFee         = "pay_bundle_licensing_fee"
+	EventTypeRedeemMintVoucher             = "redeem_mint_voucher"
+	EventTypeMintVoucherRedeemed           = "mint_voucher_redeemed"
 	
-	AttributeKeyReceiver   = "receiver"
-	AttributeValueCategory = fmt.Sprintf("%s_%s", ibctypes.ModuleName, ModuleName)
+	AttributeKeyReceiver       = "receiver"
+	AttributeKeyPrimaryNFTID   = "primary_nft_id"
+	AttributeKeySecondaryNFTID = "secondary_nft_id"
+	AttributeKeyOfferID        = "offer_id"
+	AttributeKeyOfferStatus    = "offer_status"
+	AttributeKeyNonce          = "nonce"
+	AttributeKeyParentNFTID    = "parent_nft_id"
+	AttributeKeyBundleID       = "bundle_id"
+	AttributeKeyCreator        = "creator"
+	AttributeValueCategory     = fmt.Sprintf("%s_%s", ibctypes.ModuleName, ModuleName)
 )
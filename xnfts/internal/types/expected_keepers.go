@@ -32,6 +32,33 @@ type (
 		SetGlobalTweetCount(ctx sdk.Context, count uint64)
 		GetGlobalTweetCount(ctx sdk.Context) uint64
 		SetTweetIDToAccount(ctx sdk.Context, add sdk.AccAddress, id string)
+		RemoveTweetIDFromAccount(ctx sdk.Context, addr sdk.AccAddress, id string)
+		
+		SetLicenseGrant(ctx sdk.Context, grant nfts.LicenseGrant)
+		GetLicenseGrant(ctx sdk.Context, primaryNFTID, channel, secondaryNFTID string) (nfts.LicenseGrant, bool)
+		GetLicenseGrants(ctx sdk.Context, primaryNFTID string) []nfts.LicenseGrant
+		
+		GetAuction(ctx sdk.Context, id uint64) (nfts.Auction, bool)
+		SetAuction(ctx sdk.Context, auction nfts.Auction)
+		DequeueEndedAuctions(ctx sdk.Context, kind string) []nfts.Auction
+		InsertAuctionQueue(ctx sdk.Context, auction nfts.Auction)
+		SettleAuction(ctx sdk.Context, auction nfts.Auction) (sdk.Coin, error)
+		CloseAuction(ctx sdk.Context, auction nfts.Auction) error
+		
+		ConsumeLicenseApproval(ctx sdk.Context, primaryNFTID, channel, recipient string) bool
+		IsOwnerOrApproved(ctx sdk.Context, nft nfts.BaseTweetNFT, addr sdk.AccAddress) bool
+		IsEscrowed(ctx sdk.Context, primaryNFTID string) bool
+		Payouts(ctx sdk.Context, nft nfts.BaseTweetNFT, amount sdk.Coin) ([]nfts.Payout, error)
+		
+		GetBundle(ctx sdk.Context, id uint64) (nfts.Bundle, bool)
+		
+		GetCollection(ctx sdk.Context, id string) (nfts.Collection, bool)
+		SetCollection(ctx sdk.Context, collection nfts.Collection)
+		
+		GetParams(ctx sdk.Context) nfts.Params
+		
+		ValidateMint(ctx sdk.Context, msg nfts.MsgMintTweetNFT) (sdk.Dec, error)
+		MintNewTweetNFT(ctx sdk.Context, msg nfts.MsgMintTweetNFT, royaltyRate sdk.Dec) nfts.BaseTweetNFT
 	}
 	
 	BaseBankKeeper interface {
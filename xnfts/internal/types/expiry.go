@@ -0,0 +1,34 @@
+package types
+
+import (
+	"time"
+)
+
+// ExpiringLicense is queued on the licensee chain for every secondary nft whose
+// license expires, together with the channel the license was received on.
+type ExpiringLicense struct {
+	PrimaryNFTID   string    `json:"primary_nft_id"`
+	SecondaryNFTID string    `json:"secondary_nft_id"`
+	Port           string    `json:"port"`
+	Channel        string    `json:"channel"`
+	ExpiryHeight   int64     `json:"expiry_height"`
+	ExpiryTime     time.Time `json:"expiry_time"`
+}
+
+func NewExpiringLicense(primaryNFTID, secondaryNFTID, port, channel string, height int64, expiry time.Time) ExpiringLicense {
+	return ExpiringLicense{
+		PrimaryNFTID:   primaryNFTID,
+		SecondaryNFTID: secondaryNFTID,
+		Port:           port,
+		Channel:        channel,
+		ExpiryHeight:   height,
+		ExpiryTime:     expiry,
+	}
+}
+
+// LicenseExpiryNotice is an expiry the primary chain has not been notified of, it is sent again
+// at the retry height.
+type LicenseExpiryNotice struct {
+	License     ExpiringLicense `json:"license"`
+	RetryHeight int64           `json:"retry_height"`
+}
@@ -1,9 +1,42 @@
 package types
 
 type GenesisState struct {
-	PortID string `json:"port_id"`
+	PortID            string            `json:"port_id"`
+	ExpiringLicenses  []ExpiringLicense `json:"expiring_licenses"`
+	Subscriptions     []Subscription    `json:"subscriptions"`
+	LicenseOffers     []LicenseOffer    `json:"license_offers"`
+	LicenseOfferCount uint64            `json:"license_offer_count"`
+	
+	SignedOfferRedemptions []SignedOfferRedemption `json:"signed_offer_redemptions"`
+	RevokedSignedOffers    []RevokedSignedOffer    `json:"revoked_signed_offers"`
+	MintVoucherRedemptions []MintVoucherRedemption `json:"mint_voucher_redemptions"`
+	SecondaryNFTIndexes    []SecondaryNFTIndex     `json:"secondary_nft_indexes"`
+	LicenseExpiryNotices   []LicenseExpiryNotice   `json:"license_expiry_notices"`
+	PendingLicenses        []PendingLicense        `json:"pending_licenses"`
+	PendingAuctionGrants   []PendingAuctionGrant   `json:"pending_auction_grants"`
 }
 
 func DefaultGenesis() GenesisState {
 	return GenesisState{PortID: PortID}
 }
+
+// PendingLicense holds a license slot of a primary nft while its license packet is in flight.
+type PendingLicense struct {
+	PrimaryNFTID string `json:"primary_nft_id"`
+	Channel      string `json:"channel"`
+	Sequence     uint64 `json:"sequence"`
+	Licensee     string `json:"licensee"`
+}
+
+// PendingAuctionGrant links a license packet in flight to the auction it settles.
+type PendingAuctionGrant struct {
+	Channel   string `json:"channel"`
+	Sequence  uint64 `json:"sequence"`
+	AuctionID uint64 `json:"auction_id"`
+}
+
+// SecondaryNFTIndex links a secondary nft held on the licensee chain to the primary nft it licenses.
+type SecondaryNFTIndex struct {
+	PrimaryNFTID   string `json:"primary_nft_id"`
+	SecondaryNFTID string `json:"secondary_nft_id"`
+}
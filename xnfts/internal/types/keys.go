@@ -1,6 +1,11 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"fmt"
+	"time"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 const (
 	ModuleName = "xnfts"
@@ -13,6 +18,103 @@ const (
 	PortKey      = "portID"
 )
 
+var (
+	PendingLicensePrefix           = []byte{0x01}
+	LicenseExpiryTimeQueuePrefix   = []byte{0x02}
+	LicenseExpiryHeightQueuePrefix = []byte{0x03}
+	SubscriptionPrefix             = []byte{0x04}
+	SubscriptionQueuePrefix        = []byte{0x05}
+	LicenseOfferPrefix             = []byte{0x06}
+	LicenseOfferCountKey           = []byte{0x07}
+	LicenseOfferQueuePrefix        = []byte{0x08}
+	SignedOfferRedemptionPrefix    = []byte{0x09}
+	PendingAuctionGrantPrefix      = []byte{0x0A}
+	MintVoucherPrefix              = []byte{0x0B}
+	SecondaryNFTIndexPrefix        = []byte{0x0C}
+	LicenseExpiryNoticeQueuePrefix = []byte{0x0D}
+	RevokedSignedOfferPrefix       = []byte{0x0E}
+)
+
+func GetPendingLicensesKey(primaryNFTID string) []byte {
+	return append(PendingLicensePrefix, []byte(primaryNFTID+"/")...)
+}
+
+func GetPendingLicenseKey(primaryNFTID, channel string, sequence uint64) []byte {
+	return append(GetPendingLicensesKey(primaryNFTID), []byte(fmt.Sprintf("%s/%d", channel, sequence))...)
+}
+
+func GetLicenseExpiryTimeKey(expiry time.Time) []byte {
+	return append(LicenseExpiryTimeQueuePrefix, sdk.FormatTimeBytes(expiry)...)
+}
+
+func GetLicenseExpiryTimeQueueKey(expiry time.Time, secondaryNFTID string) []byte {
+	return append(GetLicenseExpiryTimeKey(expiry), []byte(secondaryNFTID)...)
+}
+
+func GetLicenseExpiryHeightKey(height int64) []byte {
+	return append(LicenseExpiryHeightQueuePrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+func GetLicenseExpiryHeightQueueKey(height int64, secondaryNFTID string) []byte {
+	return append(GetLicenseExpiryHeightKey(height), []byte(secondaryNFTID)...)
+}
+
+func GetSubscriptionKey(primaryNFTID, subscriber string) []byte {
+	return append(SubscriptionPrefix, []byte(primaryNFTID+"/"+subscriber)...)
+}
+
+func GetSubscriptionQueueHeightKey(height int64) []byte {
+	return append(SubscriptionQueuePrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+func GetSubscriptionQueueKey(height int64, primaryNFTID, subscriber string) []byte {
+	return append(GetSubscriptionQueueHeightKey(height), []byte(primaryNFTID+"/"+subscriber)...)
+}
+
+func GetLicenseOfferKey(channel string, id uint64) []byte {
+	return append(LicenseOfferPrefix, append([]byte(channel+"/"), sdk.Uint64ToBigEndian(id)...)...)
+}
+
+func GetLicenseOfferQueueTimeKey(expiry time.Time) []byte {
+	return append(LicenseOfferQueuePrefix, sdk.FormatTimeBytes(expiry)...)
+}
+
+func GetLicenseOfferQueueKey(expiry time.Time, channel string, id uint64) []byte {
+	return append(GetLicenseOfferQueueTimeKey(expiry), append([]byte(channel+"/"), sdk.Uint64ToBigEndian(id)...)...)
+}
+
+func GetSignedOfferRedemptionKey(owner string, nonce uint64) []byte {
+	return append(SignedOfferRedemptionPrefix, append([]byte(owner+"/"), sdk.Uint64ToBigEndian(nonce)...)...)
+}
+
+func GetRevokedSignedOfferKey(owner string, nonce uint64) []byte {
+	return append(RevokedSignedOfferPrefix, append([]byte(owner+"/"), sdk.Uint64ToBigEndian(nonce)...)...)
+}
+
+func GetPendingAuctionGrantKey(channel string, sequence uint64) []byte {
+	return append(PendingAuctionGrantPrefix, append([]byte(channel+"/"), sdk.Uint64ToBigEndian(sequence)...)...)
+}
+
+func GetMintVoucherKey(creator string, nonce uint64) []byte {
+	return append(MintVoucherPrefix, append([]byte(creator+"/"), sdk.Uint64ToBigEndian(nonce)...)...)
+}
+
+func GetSecondaryNFTsKey(primaryNFTID string) []byte {
+	return append(SecondaryNFTIndexPrefix, []byte(primaryNFTID+"/")...)
+}
+
+func GetSecondaryNFTIndexKey(primaryNFTID, secondaryNFTID string) []byte {
+	return append(GetSecondaryNFTsKey(primaryNFTID), []byte(secondaryNFTID)...)
+}
+
+func GetLicenseExpiryNoticeHeightKey(height int64) []byte {
+	return append(LicenseExpiryNoticeQueuePrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+func GetLicenseExpiryNoticeQueueKey(height int64, secondaryNFTID string) []byte {
+	return append(GetLicenseExpiryNoticeHeightKey(height), []byte(secondaryNFTID)...)
+}
+
 func GetHexAddressFromBech32String(addr string) sdk.AccAddress {
 	addrs, _ := sdk.AccAddressFromBech32(addr)
 	return addrs
@@ -0,0 +1,165 @@
+package types
+
+import (
+	"fmt"
+	"time"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/tendermint/tendermint/crypto"
+	
+	"github.com/FreeFlixMedia/modules/nfts"
+)
+
+// MintVoucherTerms describe a primary nft the creator has not minted yet, the first licensing
+// fee paid with the voucher mints the nft to the creator.
+type MintVoucherTerms struct {
+	Creator       string `json:"creator"`
+	AssetID       string `json:"asset_id"`
+	Platform      string `json:"platform"`
+	TwitterHandle string `json:"twitter_handle"`
+	
+	LicenseTerms nfts.LicenseTerms `json:"license_terms"`
+	LicensingFee sdk.Coin          `json:"licensing_fee"`
+	RevenueShare sdk.Dec           `json:"revenue_share"`
+	RoyaltyRate  sdk.Dec           `json:"royalty_rate"`
+	MaxLicensees uint64            `json:"max_licensees"`
+	Metadata     nfts.Metadata     `json:"metadata"`
+	
+	Nonce  uint64    `json:"nonce"`
+	Expiry time.Time `json:"expiry"`
+	
+	// ChainID is the primary chain the voucher is minted on
+	ChainID string `json:"chain_id"`
+}
+
+func NewMintVoucherTerms(creator, assetID, platform, handle string, terms nfts.LicenseTerms, fee sdk.Coin,
+	share, royaltyRate sdk.Dec, maxLicensees uint64, metadata nfts.Metadata, nonce uint64, expiry time.Time,
+	chainID string) MintVoucherTerms {
+	platform = nfts.NormalizePlatform(platform)
+	return MintVoucherTerms{
+		Creator:       creator,
+		AssetID:       assetID,
+		Platform:      platform,
+		TwitterHandle: nfts.NormalizeHandle(platform, handle),
+		LicenseTerms:  terms,
+		LicensingFee:  fee,
+		RevenueShare:  share,
+		RoyaltyRate:   royaltyRate,
+		MaxLicensees:  maxLicensees,
+		Metadata:      metadata,
+		Nonce:         nonce,
+		Expiry:        expiry,
+		ChainID:       chainID,
+	}
+}
+
+func (t MintVoucherTerms) GetSignBytes() []byte {
+	return getSignBytes(SignTypeMintVoucher, t)
+}
+
+// ToMsgMintTweetNFT is the mint the voucher stands for, the creator mints outside of collections.
+func (t MintVoucherTerms) ToMsgMintTweetNFT() nfts.MsgMintTweetNFT {
+	creator, _ := sdk.AccAddressFromBech32(t.Creator)
+	terms := t.LicenseTerms
+	return nfts.NewMsgMintNFT(creator, "", t.AssetID, &terms, t.LicensingFee, t.RevenueShare, t.MaxLicensees, 0,
+		t.RoyaltyRate, nil, t.Platform, t.TwitterHandle, t.Metadata)
+}
+
+func (t MintVoucherTerms) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(t.Creator); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid creator address")
+	}
+	if !t.LicensingFee.IsValid() || t.LicensingFee.IsZero() {
+		return sdkerrors.ErrInvalidCoins
+	}
+	if t.RevenueShare.IsNil() || t.RevenueShare.IsNegative() || t.RevenueShare.GT(sdk.OneDec()) {
+		return sdkerrors.Wrap(ErrInvalidMintVoucher, "revenue share should be between 0 and 1")
+	}
+	if t.Expiry.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidMintVoucher, "missing voucher expiry")
+	}
+	if len(t.ChainID) == 0 {
+		return sdkerrors.Wrap(ErrInvalidMintVoucher, "missing chain id")
+	}
+	return t.ToMsgMintTweetNFT().ValidateBasic()
+}
+
+type MintVoucher struct {
+	Terms     MintVoucherTerms `json:"terms"`
+	PubKey    crypto.PubKey    `json:"pub_key"`
+	Signature []byte           `json:"signature"`
+}
+
+func NewMintVoucher(terms MintVoucherTerms, pubKey crypto.PubKey, signature []byte) MintVoucher {
+	return MintVoucher{
+		Terms:     terms,
+		PubKey:    pubKey,
+		Signature: signature,
+	}
+}
+
+func (v MintVoucher) Signer() sdk.AccAddress {
+	return sdk.AccAddress(v.PubKey.Address())
+}
+
+func (v MintVoucher) VerifySignature() bool {
+	return v.PubKey != nil && v.PubKey.VerifyBytes(v.Terms.GetSignBytes(), v.Signature)
+}
+
+func (v MintVoucher) IsExpired(now time.Time) bool {
+	return !now.Before(v.Terms.Expiry)
+}
+
+func (v MintVoucher) ValidateBasic() error {
+	if err := v.Terms.ValidateBasic(); err != nil {
+		return err
+	}
+	if !v.VerifySignature() {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "invalid voucher signature")
+	}
+	return nil
+}
+
+func (v MintVoucher) String() string {
+	return fmt.Sprintf(`
+Creator: %s,
+AssetID: %s,
+Platform: %s,
+TwitterHandle: %s,
+LicensingFee: %s,
+RevenueShare: %s,
+RoyaltyRate: %s,
+MaxLicensees: %d,
+Nonce: %d,
+Expiry: %s,
+ChainID: %s,
+Signer: %s
+`, v.Terms.Creator, v.Terms.AssetID, v.Terms.Platform, v.Terms.TwitterHandle, v.Terms.LicensingFee,
+		v.Terms.RevenueShare, v.Terms.RoyaltyRate, v.Terms.MaxLicensees, v.Terms.Nonce, v.Terms.Expiry, v.Terms.ChainID,
+		v.Signer())
+}
+
+// MintVoucherInspection is the voucher with the checks a licensee makes before redeeming it.
+type MintVoucherInspection struct {
+	Voucher        MintVoucher    `json:"voucher" yaml:"voucher"`
+	Signer         sdk.AccAddress `json:"signer" yaml:"signer"`
+	ValidSignature bool           `json:"valid_signature" yaml:"valid_signature"`
+	Expired        bool           `json:"expired" yaml:"expired"`
+}
+
+func NewMintVoucherInspection(voucher MintVoucher, now time.Time) MintVoucherInspection {
+	return MintVoucherInspection{
+		Voucher:        voucher,
+		Signer:         voucher.Signer(),
+		ValidSignature: voucher.VerifySignature(),
+		Expired:        voucher.IsExpired(now),
+	}
+}
+
+// MintVoucherRedemption records the primary nft a voucher was minted as.
+type MintVoucherRedemption struct {
+	Creator      string `json:"creator"`
+	Nonce        uint64 `json:"nonce"`
+	PrimaryNFTID string `json:"primary_nft_id"`
+}
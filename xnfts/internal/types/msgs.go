@@ -19,6 +19,7 @@ type NFTInput struct {
 	LicensingFee  sdk.Coin `json:"licensing_fee"`
 	RevenueShare  sdk.Dec  `json:"revenue_share"`
 	TwitterHandle string   `json:"twitter_handle"`
+	Platform      string   `json:"platform"`
 }
 
 type MsgXNFTTransfer struct {
@@ -76,6 +77,8 @@ func (m MsgXNFTTransfer) ValidateBasic() error {
 			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "revenue share is not allowed to be empty")
 		} else if m.NFTInput.TwitterHandle == "" {
 			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "handle name should not be empty")
+		} else if !nfts.IsValidPlatform(nfts.NormalizePlatform(m.NFTInput.Platform)) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unknown platform %s", m.NFTInput.Platform)
 		} else if !m.NFTInput.LicensingFee.IsValid() {
 			return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "licensing fee is invalid")
 		}
@@ -175,3 +178,781 @@ func (m MsgPayLicensingFee) GetSignBytes() []byte {
 func (m MsgPayLicensingFee) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{m.Sender}
 }
+
+// --------------------------------------------------------------------
+
+type MsgSubscribeLicense struct {
+	Sender       sdk.AccAddress `json:"sender"`
+	Recipient    string         `json:"recipient"`
+	LicensingFee sdk.Coin       `json:"licensing_fee"`
+	Deposit      sdk.Coin       `json:"deposit"`
+	PrimaryNFTID string         `json:"primary_nft_id"`
+	
+	SrcPort    string `json:"src_port"`
+	SrcChannel string `json:"src_channel"`
+	DestHeight uint64 `json:"dest_height"`
+}
+
+func NewMsgSubscribeLicense(
+	sourcePort, sourceChannel, primaryNFTID string, destHeight uint64, fee, deposit sdk.Coin, sender sdk.AccAddress, receiver string,
+) MsgSubscribeLicense {
+	return MsgSubscribeLicense{
+		SrcPort:      sourcePort,
+		SrcChannel:   sourceChannel,
+		DestHeight:   destHeight,
+		PrimaryNFTID: primaryNFTID,
+		LicensingFee: fee,
+		Deposit:      deposit,
+		Sender:       sender,
+		Recipient:    receiver,
+	}
+}
+
+var _ sdk.Msg = MsgSubscribeLicense{}
+
+func (m MsgSubscribeLicense) Route() string {
+	return RouterKey
+}
+
+func (m MsgSubscribeLicense) Type() string {
+	return "msg_subscribe_license"
+}
+
+func (m MsgSubscribeLicense) ValidateBasic() error {
+	if err := m.ToMsgPayLicensingFee().ValidateBasic(); err != nil {
+		return err
+	}
+	
+	if !m.Deposit.IsValid() || m.Deposit.Denom != m.LicensingFee.Denom {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "deposit should be in licensing fee denom")
+	}
+	return nil
+}
+
+func (m MsgSubscribeLicense) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgSubscribeLicense) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
+
+// ToMsgPayLicensingFee returns the payment of the first subscription period.
+func (m MsgSubscribeLicense) ToMsgPayLicensingFee() MsgPayLicensingFee {
+	return NewMsgPayLicensingFee(m.SrcPort, m.SrcChannel, m.PrimaryNFTID, m.DestHeight, m.LicensingFee, m.Sender, m.Recipient)
+}
+
+// --------------------------------------------------------------------
+
+type MsgTopUpSubscription struct {
+	Sender       sdk.AccAddress `json:"sender"`
+	PrimaryNFTID string         `json:"primary_nft_id"`
+	Amount       sdk.Coin       `json:"amount"`
+}
+
+func NewMsgTopUpSubscription(sender sdk.AccAddress, primaryNFTID string, amount sdk.Coin) MsgTopUpSubscription {
+	return MsgTopUpSubscription{
+		Sender:       sender,
+		PrimaryNFTID: primaryNFTID,
+		Amount:       amount,
+	}
+}
+
+var _ sdk.Msg = MsgTopUpSubscription{}
+
+func (m MsgTopUpSubscription) Route() string {
+	return RouterKey
+}
+
+func (m MsgTopUpSubscription) Type() string {
+	return "msg_top_up_subscription"
+}
+
+func (m MsgTopUpSubscription) ValidateBasic() error {
+	if m.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing sender address")
+	}
+	if len(m.PrimaryNFTID) == 0 {
+		return fmt.Errorf("invalid input field, primary nfts id")
+	}
+	if !m.Amount.IsValid() || m.Amount.IsZero() {
+		return sdkerrors.ErrInvalidCoins
+	}
+	return nil
+}
+
+func (m MsgTopUpSubscription) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgTopUpSubscription) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
+
+// --------------------------------------------------------------------
+
+type MsgCancelSubscription struct {
+	Sender       sdk.AccAddress `json:"sender"`
+	PrimaryNFTID string         `json:"primary_nft_id"`
+}
+
+func NewMsgCancelSubscription(sender sdk.AccAddress, primaryNFTID string) MsgCancelSubscription {
+	return MsgCancelSubscription{
+		Sender:       sender,
+		PrimaryNFTID: primaryNFTID,
+	}
+}
+
+var _ sdk.Msg = MsgCancelSubscription{}
+
+func (m MsgCancelSubscription) Route() string {
+	return RouterKey
+}
+
+func (m MsgCancelSubscription) Type() string {
+	return "msg_cancel_subscription"
+}
+
+func (m MsgCancelSubscription) ValidateBasic() error {
+	if m.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing sender address")
+	}
+	if len(m.PrimaryNFTID) == 0 {
+		return fmt.Errorf("invalid input field, primary nfts id")
+	}
+	return nil
+}
+
+func (m MsgCancelSubscription) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgCancelSubscription) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
+
+// --------------------------------------------------------------------
+
+type MsgMakeLicenseOffer struct {
+	SrcPort    string         `json:"src_port"`
+	SrcChannel string         `json:"src_channel"`
+	DestHeight uint64         `json:"dest_height"`
+	Sender     sdk.AccAddress `json:"sender"`
+	Recipient  string         `json:"recipient"`
+	
+	PrimaryNFTID   string   `json:"primary_nft_id"`
+	Fee            sdk.Coin `json:"fee"`
+	RevenueShare   sdk.Dec  `json:"revenue_share"`
+	DurationBlocks int64    `json:"duration_blocks"`
+	ExpirySeconds  int64    `json:"expiry_seconds"`
+}
+
+func NewMsgMakeLicenseOffer(srcPort, srcChannel string, destHeight uint64, sender sdk.AccAddress, recipient,
+	primaryNFTID string, fee sdk.Coin, share sdk.Dec, durationBlocks, expirySeconds int64) MsgMakeLicenseOffer {
+	return MsgMakeLicenseOffer{
+		SrcPort:        srcPort,
+		SrcChannel:     srcChannel,
+		DestHeight:     destHeight,
+		Sender:         sender,
+		Recipient:      recipient,
+		PrimaryNFTID:   primaryNFTID,
+		Fee:            fee,
+		RevenueShare:   share,
+		DurationBlocks: durationBlocks,
+		ExpirySeconds:  expirySeconds,
+	}
+}
+
+var _ sdk.Msg = MsgMakeLicenseOffer{}
+
+func (m MsgMakeLicenseOffer) Route() string {
+	return RouterKey
+}
+
+func (m MsgMakeLicenseOffer) Type() string {
+	return "msg_make_license_offer"
+}
+
+func (m MsgMakeLicenseOffer) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(m.SrcPort); err != nil {
+		return sdkerrors.Wrap(err, "invalid source port ID")
+	}
+	if err := host.ChannelIdentifierValidator(m.SrcChannel); err != nil {
+		return sdkerrors.Wrap(err, "invalid source channel ID")
+	}
+	if m.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing sender address")
+	}
+	if len(m.Recipient) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing recipient address")
+	}
+	if len(m.PrimaryNFTID) == 0 {
+		return fmt.Errorf("invalid input field, primary nfts id")
+	}
+	if !m.Fee.IsValid() || m.Fee.IsZero() {
+		return sdkerrors.ErrInvalidCoins
+	}
+	if m.RevenueShare.IsNil() || m.RevenueShare.IsNegative() || m.RevenueShare.GT(sdk.OneDec()) {
+		return sdkerrors.Wrap(ErrInvalidLicenseOffer, "revenue share should be between 0 and 1")
+	}
+	if m.DurationBlocks < 0 {
+		return sdkerrors.Wrap(ErrInvalidLicenseOffer, "license duration can not be negative")
+	}
+	if m.ExpirySeconds <= 0 {
+		return sdkerrors.Wrap(ErrInvalidLicenseOffer, "offer expiry should be positive")
+	}
+	return nil
+}
+
+func (m MsgMakeLicenseOffer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgMakeLicenseOffer) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
+
+// --------------------------------------------------------------------
+
+type MsgAcceptLicenseOffer struct {
+	Sender  sdk.AccAddress `json:"sender"`
+	Channel string         `json:"channel"`
+	OfferID uint64         `json:"offer_id"`
+}
+
+func NewMsgAcceptLicenseOffer(sender sdk.AccAddress, channel string, offerID uint64) MsgAcceptLicenseOffer {
+	return MsgAcceptLicenseOffer{
+		Sender:  sender,
+		Channel: channel,
+		OfferID: offerID,
+	}
+}
+
+var _ sdk.Msg = MsgAcceptLicenseOffer{}
+
+func (m MsgAcceptLicenseOffer) Route() string {
+	return RouterKey
+}
+
+func (m MsgAcceptLicenseOffer) Type() string {
+	return "msg_accept_license_offer"
+}
+
+func (m MsgAcceptLicenseOffer) ValidateBasic() error {
+	if m.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing sender address")
+	}
+	if err := host.ChannelIdentifierValidator(m.Channel); err != nil {
+		return sdkerrors.Wrap(err, "invalid channel ID")
+	}
+	return nil
+}
+
+func (m MsgAcceptLicenseOffer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgAcceptLicenseOffer) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
+
+// --------------------------------------------------------------------
+
+type MsgRejectLicenseOffer struct {
+	Sender  sdk.AccAddress `json:"sender"`
+	Channel string         `json:"channel"`
+	OfferID uint64         `json:"offer_id"`
+}
+
+func NewMsgRejectLicenseOffer(sender sdk.AccAddress, channel string, offerID uint64) MsgRejectLicenseOffer {
+	return MsgRejectLicenseOffer{
+		Sender:  sender,
+		Channel: channel,
+		OfferID: offerID,
+	}
+}
+
+var _ sdk.Msg = MsgRejectLicenseOffer{}
+
+func (m MsgRejectLicenseOffer) Route() string {
+	return RouterKey
+}
+
+func (m MsgRejectLicenseOffer) Type() string {
+	return "msg_reject_license_offer"
+}
+
+func (m MsgRejectLicenseOffer) ValidateBasic() error {
+	if m.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing sender address")
+	}
+	if err := host.ChannelIdentifierValidator(m.Channel); err != nil {
+		return sdkerrors.Wrap(err, "invalid channel ID")
+	}
+	return nil
+}
+
+func (m MsgRejectLicenseOffer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgRejectLicenseOffer) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
+
+// --------------------------------------------------------------------
+
+type MsgRedeemLicenseOffer struct {
+	SrcPort    string             `json:"src_port"`
+	SrcChannel string             `json:"src_channel"`
+	DestHeight uint64             `json:"dest_height"`
+	Sender     sdk.AccAddress     `json:"sender"`
+	Offer      SignedLicenseOffer `json:"offer"`
+}
+
+func NewMsgRedeemLicenseOffer(srcPort, srcChannel string, destHeight uint64, sender sdk.AccAddress,
+	offer SignedLicenseOffer) MsgRedeemLicenseOffer {
+	return MsgRedeemLicenseOffer{
+		SrcPort:    srcPort,
+		SrcChannel: srcChannel,
+		DestHeight: destHeight,
+		Sender:     sender,
+		Offer:      offer,
+	}
+}
+
+var _ sdk.Msg = MsgRedeemLicenseOffer{}
+
+func (m MsgRedeemLicenseOffer) Route() string {
+	return RouterKey
+}
+
+func (m MsgRedeemLicenseOffer) Type() string {
+	return "msg_redeem_license_offer"
+}
+
+func (m MsgRedeemLicenseOffer) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(m.SrcPort); err != nil {
+		return sdkerrors.Wrap(err, "invalid source port ID")
+	}
+	if err := host.ChannelIdentifierValidator(m.SrcChannel); err != nil {
+		return sdkerrors.Wrap(err, "invalid source channel ID")
+	}
+	if m.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing sender address")
+	}
+	if err := m.Offer.ValidateBasic(); err != nil {
+		return err
+	}
+	if !m.Offer.IsLicenseeAllowed(m.Sender.String()) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "offer is made to another licensee")
+	}
+	return nil
+}
+
+func (m MsgRedeemLicenseOffer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgRedeemLicenseOffer) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
+
+func (m MsgRedeemLicenseOffer) ToMsgPayLicensingFee() MsgPayLicensingFee {
+	return NewMsgPayLicensingFee(m.SrcPort, m.SrcChannel, m.Offer.Terms.PrimaryNFTID, m.DestHeight,
+		m.Offer.Terms.Fee, m.Sender, m.Offer.Terms.Owner)
+}
+
+// --------------------------------------------------------------------
+
+// MsgRevokeSignedOffer withdraws the signed offers of the sender made with the nonce.
+type MsgRevokeSignedOffer struct {
+	Sender sdk.AccAddress `json:"sender"`
+	Nonce  uint64         `json:"nonce"`
+}
+
+func NewMsgRevokeSignedOffer(sender sdk.AccAddress, nonce uint64) MsgRevokeSignedOffer {
+	return MsgRevokeSignedOffer{
+		Sender: sender,
+		Nonce:  nonce,
+	}
+}
+
+var _ sdk.Msg = MsgRevokeSignedOffer{}
+
+func (m MsgRevokeSignedOffer) Route() string {
+	return RouterKey
+}
+
+func (m MsgRevokeSignedOffer) Type() string {
+	return "msg_revoke_signed_offer"
+}
+
+func (m MsgRevokeSignedOffer) ValidateBasic() error {
+	if m.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing sender address")
+	}
+	return nil
+}
+
+func (m MsgRevokeSignedOffer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgRevokeSignedOffer) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
+
+// --------------------------------------------------------------------
+
+type MsgOfferSublicense struct {
+	Sender         sdk.AccAddress `json:"sender"`
+	SecondaryNFTID string         `json:"secondary_nft_id"`
+	LicensingFee   sdk.Coin       `json:"licensing_fee"`
+	RevenueShare   sdk.Dec        `json:"revenue_share"`
+}
+
+func NewMsgOfferSublicense(sender sdk.AccAddress, secondaryNFTID string, fee sdk.Coin, share sdk.Dec) MsgOfferSublicense {
+	return MsgOfferSublicense{
+		Sender:         sender,
+		SecondaryNFTID: secondaryNFTID,
+		LicensingFee:   fee,
+		RevenueShare:   share,
+	}
+}
+
+var _ sdk.Msg = MsgOfferSublicense{}
+
+func (m MsgOfferSublicense) Route() string {
+	return RouterKey
+}
+
+func (m MsgOfferSublicense) Type() string {
+	return "msg_offer_sublicense"
+}
+
+func (m MsgOfferSublicense) ValidateBasic() error {
+	if m.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing sender address")
+	}
+	if m.SecondaryNFTID == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "secondary nft id should not be empty")
+	}
+	if !m.LicensingFee.IsValid() || m.LicensingFee.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "sublicense fee should be positive")
+	}
+	if m.RevenueShare.IsNil() || m.RevenueShare.IsNegative() || m.RevenueShare.GT(sdk.OneDec()) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "revenue share should be between 0 and 1")
+	}
+	return nil
+}
+
+func (m MsgOfferSublicense) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgOfferSublicense) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
+
+// --------------------------------------------------------------------
+
+// MsgBuySublicense takes a sublicense of a secondary nft, the parent nft lives on the chain
+// at the other end of SrcChannel or on this chain when SrcChannel is empty.
+type MsgBuySublicense struct {
+	SrcPort      string         `json:"src_port"`
+	SrcChannel   string         `json:"src_channel"`
+	DestHeight   uint64         `json:"dest_height"`
+	Sender       sdk.AccAddress `json:"sender"`
+	ParentNFTID  string         `json:"parent_nft_id"`
+	LicensingFee sdk.Coin       `json:"licensing_fee"`
+}
+
+func NewMsgBuySublicense(srcPort, srcChannel string, destHeight uint64, sender sdk.AccAddress,
+	parentNFTID string, fee sdk.Coin) MsgBuySublicense {
+	return MsgBuySublicense{
+		SrcPort:      srcPort,
+		SrcChannel:   srcChannel,
+		DestHeight:   destHeight,
+		Sender:       sender,
+		ParentNFTID:  parentNFTID,
+		LicensingFee: fee,
+	}
+}
+
+var _ sdk.Msg = MsgBuySublicense{}
+
+func (m MsgBuySublicense) Route() string {
+	return RouterKey
+}
+
+func (m MsgBuySublicense) Type() string {
+	return "msg_buy_sublicense"
+}
+
+func (m MsgBuySublicense) ValidateBasic() error {
+	if m.SrcChannel != "" {
+		if err := host.PortIdentifierValidator(m.SrcPort); err != nil {
+			return sdkerrors.Wrap(err, "invalid source port ID")
+		}
+		if err := host.ChannelIdentifierValidator(m.SrcChannel); err != nil {
+			return sdkerrors.Wrap(err, "invalid source channel ID")
+		}
+	}
+	if m.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing sender address")
+	}
+	if m.ParentNFTID == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "parent nft id should not be empty")
+	}
+	if !m.LicensingFee.IsValid() || m.LicensingFee.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "sublicense fee should be positive")
+	}
+	return nil
+}
+
+func (m MsgBuySublicense) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgBuySublicense) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
+
+// --------------------------------------------------------------------
+
+type MsgTransferSecondaryNFT struct {
+	Sender         sdk.AccAddress `json:"sender"`
+	SecondaryNFTID string         `json:"secondary_nft_id"`
+	Recipient      sdk.AccAddress `json:"recipient"`
+}
+
+func NewMsgTransferSecondaryNFT(sender sdk.AccAddress, secondaryNFTID string, recipient sdk.AccAddress) MsgTransferSecondaryNFT {
+	return MsgTransferSecondaryNFT{
+		Sender:         sender,
+		SecondaryNFTID: secondaryNFTID,
+		Recipient:      recipient,
+	}
+}
+
+var _ sdk.Msg = MsgTransferSecondaryNFT{}
+
+func (m MsgTransferSecondaryNFT) Route() string {
+	return RouterKey
+}
+
+func (m MsgTransferSecondaryNFT) Type() string {
+	return "msg_transfer_secondary_nft"
+}
+
+func (m MsgTransferSecondaryNFT) ValidateBasic() error {
+	if m.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing sender address")
+	}
+	if m.Recipient.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing recipient address")
+	}
+	if m.Sender.Equals(m.Recipient) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "recipient should differ from sender")
+	}
+	if m.SecondaryNFTID == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "secondary nft id should not be empty")
+	}
+	return nil
+}
+
+func (m MsgTransferSecondaryNFT) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgTransferSecondaryNFT) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
+
+// --------------------------------------------------------------------
+
+const MaxBatchTransferNFTs = 50
+
+// MsgXNFTBatchTransfer licenses many primary nfts to the recipient over a single packet.
+type MsgXNFTBatchTransfer struct {
+	SourcePort    string         `json:"source_port"`
+	SourceChannel string         `json:"source_channel"`
+	DestHeight    uint64         `json:"dest_height"`
+	Sender        sdk.AccAddress `json:"sender"`
+	PrimaryNFTIDs []string       `json:"primary_nft_ids"`
+	Recipient     string         `json:"recipient"`
+}
+
+func NewMsgXNFTBatchTransfer(sourcePort, sourceChannel string, height uint64, sender sdk.AccAddress,
+	primaryNFTIDs []string, recipient string) MsgXNFTBatchTransfer {
+	return MsgXNFTBatchTransfer{
+		SourcePort:    sourcePort,
+		SourceChannel: sourceChannel,
+		DestHeight:    height,
+		Sender:        sender,
+		PrimaryNFTIDs: primaryNFTIDs,
+		Recipient:     recipient,
+	}
+}
+
+var _ sdk.Msg = MsgXNFTBatchTransfer{}
+
+func (m MsgXNFTBatchTransfer) Route() string {
+	return RouterKey
+}
+
+func (m MsgXNFTBatchTransfer) Type() string {
+	return "msg_xnft_batch_transfer"
+}
+
+func (m MsgXNFTBatchTransfer) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(m.SourcePort); err != nil {
+		return sdkerrors.Wrap(err, "invalid source port ID")
+	}
+	if err := host.ChannelIdentifierValidator(m.SourceChannel); err != nil {
+		return sdkerrors.Wrap(err, "invalid source channel ID")
+	}
+	if m.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	} else if m.Recipient == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Recipient should not be nil")
+	}
+	
+	if len(m.PrimaryNFTIDs) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "primary nft ids should not be empty")
+	} else if len(m.PrimaryNFTIDs) > MaxBatchTransferNFTs {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "batch can not exceed %d nfts", MaxBatchTransferNFTs)
+	}
+	
+	seen := make(map[string]bool)
+	for _, id := range m.PrimaryNFTIDs {
+		if id == "" {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "primary nft id is empty")
+		} else if seen[id] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate primary nft id %s", id)
+		}
+		seen[id] = true
+	}
+	return nil
+}
+
+func (m MsgXNFTBatchTransfer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgXNFTBatchTransfer) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
+
+// --------------------------------------------------------------------
+
+// MsgPayBundleLicensingFee pays the price of a bundle living on the chain at the other end of
+// SrcChannel, every member of the bundle is licensed to the sender.
+type MsgPayBundleLicensingFee struct {
+	SrcPort      string         `json:"src_port"`
+	SrcChannel   string         `json:"src_channel"`
+	DestHeight   uint64         `json:"dest_height"`
+	Sender       sdk.AccAddress `json:"sender"`
+	BundleID     uint64         `json:"bundle_id"`
+	LicensingFee sdk.Coin       `json:"licensing_fee"`
+}
+
+func NewMsgPayBundleLicensingFee(srcPort, srcChannel string, destHeight uint64, sender sdk.AccAddress,
+	bundleID uint64, fee sdk.Coin) MsgPayBundleLicensingFee {
+	return MsgPayBundleLicensingFee{
+		SrcPort:      srcPort,
+		SrcChannel:   srcChannel,
+		DestHeight:   destHeight,
+		Sender:       sender,
+		BundleID:     bundleID,
+		LicensingFee: fee,
+	}
+}
+
+var _ sdk.Msg = MsgPayBundleLicensingFee{}
+
+func (m MsgPayBundleLicensingFee) Route() string {
+	return RouterKey
+}
+
+func (m MsgPayBundleLicensingFee) Type() string {
+	return "msg_pay_bundle_licensing_fee"
+}
+
+func (m MsgPayBundleLicensingFee) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(m.SrcPort); err != nil {
+		return sdkerrors.Wrap(err, "invalid source port ID")
+	}
+	if err := host.ChannelIdentifierValidator(m.SrcChannel); err != nil {
+		return sdkerrors.Wrap(err, "invalid source channel ID")
+	}
+	if m.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing sender address")
+	}
+	if !m.LicensingFee.IsValid() || m.LicensingFee.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "licensing fee should be positive")
+	}
+	return nil
+}
+
+func (m MsgPayBundleLicensingFee) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgPayBundleLicensingFee) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
+
+// --------------------------------------------------------------------
+
+// MsgRedeemMintVoucher pays the licensing fee of a voucher from the licensee chain, the primary
+// chain mints the nft of the voucher if it is the first license bought with it.
+type MsgRedeemMintVoucher struct {
+	SrcPort    string         `json:"src_port"`
+	SrcChannel string         `json:"src_channel"`
+	DestHeight uint64         `json:"dest_height"`
+	Sender     sdk.AccAddress `json:"sender"`
+	Voucher    MintVoucher    `json:"voucher"`
+}
+
+func NewMsgRedeemMintVoucher(srcPort, srcChannel string, destHeight uint64, sender sdk.AccAddress,
+	voucher MintVoucher) MsgRedeemMintVoucher {
+	return MsgRedeemMintVoucher{
+		SrcPort:    srcPort,
+		SrcChannel: srcChannel,
+		DestHeight: destHeight,
+		Sender:     sender,
+		Voucher:    voucher,
+	}
+}
+
+var _ sdk.Msg = MsgRedeemMintVoucher{}
+
+func (m MsgRedeemMintVoucher) Route() string {
+	return RouterKey
+}
+
+func (m MsgRedeemMintVoucher) Type() string {
+	return "msg_redeem_mint_voucher"
+}
+
+func (m MsgRedeemMintVoucher) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(m.SrcPort); err != nil {
+		return sdkerrors.Wrap(err, "invalid source port ID")
+	}
+	if err := host.ChannelIdentifierValidator(m.SrcChannel); err != nil {
+		return sdkerrors.Wrap(err, "invalid source channel ID")
+	}
+	if m.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing sender address")
+	}
+	return m.Voucher.ValidateBasic()
+}
+
+func (m MsgRedeemMintVoucher) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgRedeemMintVoucher) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"fmt"
+	"time"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	OfferStatusPending  = "pending"
+	OfferStatusAccepted = "accepted"
+	OfferStatusRejected = "rejected"
+	OfferStatusExpired  = "expired"
+	OfferStatusRefunded = "refunded"
+)
+
+// LicenseOffer is made on the licensee chain with the fee held in escrow and mirrored on the
+// primary chain, where the primary owner accepts or rejects it before it expires.
+type LicenseOffer struct {
+	ID           uint64 `json:"id"`
+	PrimaryNFTID string `json:"primary_nft_id"`
+	Offerer      string `json:"offerer"`
+	Recipient    string `json:"recipient"`
+	
+	Fee            sdk.Coin `json:"fee"`
+	RevenueShare   sdk.Dec  `json:"revenue_share"`
+	DurationBlocks int64    `json:"duration_blocks"`
+	
+	Expiry  time.Time `json:"expiry"`
+	Port    string    `json:"port"`
+	Channel string    `json:"channel"`
+	Status  string    `json:"status"`
+}
+
+func NewLicenseOffer(id uint64, primaryNFTID, offerer, recipient string, fee sdk.Coin, share sdk.Dec,
+	durationBlocks int64, expiry time.Time, port, channel string) LicenseOffer {
+	return LicenseOffer{
+		ID:             id,
+		PrimaryNFTID:   primaryNFTID,
+		Offerer:        offerer,
+		Recipient:      recipient,
+		Fee:            fee,
+		RevenueShare:   share,
+		DurationBlocks: durationBlocks,
+		Expiry:         expiry,
+		Port:           port,
+		Channel:        channel,
+		Status:         OfferStatusPending,
+	}
+}
+
+func (offer LicenseOffer) IsPending() bool {
+	return offer.Status == OfferStatusPending
+}
+
+func (offer LicenseOffer) String() string {
+	return fmt.Sprintf(`
+ID: %d,
+PrimaryNFTID: %s,
+Offerer: %s,
+Recipient: %s,
+Fee: %s,
+RevenueShare: %s,
+DurationBlocks: %d,
+Expiry: %s,
+Port: %s,
+Channel: %s,
+Status: %s
+`, offer.ID, offer.PrimaryNFTID, offer.Offerer, offer.Recipient, offer.Fee, offer.RevenueShare,
+		offer.DurationBlocks, offer.Expiry, offer.Port, offer.Channel, offer.Status)
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 	
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	
@@ -24,11 +25,21 @@ type BaseNFTPacket struct {
 	
 	AssetID string `json:"asset_id"`
 	
-	License      bool     `json:"license"`
-	LicensingFee sdk.Coin `json:"licensing_fee"`
-	RevenueShare sdk.Dec  `json:"revenue_share"`
+	LicenseTerms *nfts.LicenseTerms `json:"license_terms"`
+	LicensingFee sdk.Coin           `json:"licensing_fee"`
+	RevenueShare sdk.Dec            `json:"revenue_share"`
+	
+	TwitterHandle string        `json:"twitter_handle"`
+	Metadata      nfts.Metadata `json:"metadata"`
+	Platform      string        `json:"platform"`
+	
+	Collection *nfts.Collection `json:"collection,omitempty"`
 	
-	TwitterHandle string `json:"twitter_handle"`
+	MasterNFTID   string `json:"master_nft_id,omitempty"`
+	EditionNumber uint64 `json:"edition_number,omitempty"`
+	
+	ParentNFTID string   `json:"parent_nft_id,omitempty"`
+	Licensors   []string `json:"licensors,omitempty"`
 }
 
 var _ XNFTs = BaseNFTPacket{}
@@ -41,14 +52,14 @@ func (nft BaseNFTPacket) ProtoMessage() {
 }
 
 func NewBaseNFTPacket(primaryNFTID, secondaryNFTID, primaryNFTOwner, secondaryNFTOwner string,
-	assetID, twitterHandle string, license bool, fee sdk.Coin, share sdk.Dec) BaseNFTPacket {
+	assetID, twitterHandle string, terms *nfts.LicenseTerms, fee sdk.Coin, share sdk.Dec) BaseNFTPacket {
 	return BaseNFTPacket{
 		PrimaryNFTID:      primaryNFTID,
 		PrimaryNFTOwner:   primaryNFTOwner,
 		SecondaryNFTID:    secondaryNFTID,
 		SecondaryNFTOwner: secondaryNFTOwner,
 		AssetID:           assetID,
-		License:           license,
+		LicenseTerms:      terms,
 		LicensingFee:      fee,
 		RevenueShare:      share,
 		TwitterHandle:     twitterHandle,
@@ -64,13 +75,14 @@ SecondaryNFTID: %s
 SecondaryNFTOwner: %s
 
 AssetID: %s
-License: %t
+LicenseTerms: %s
 
 LicensingFee: %s
 RevenueShare: %s
 TwittterHandle: %s
-`, nft.PrimaryNFTID, nft.PrimaryNFTOwner, nft.SecondaryNFTID, nft.SecondaryNFTOwner, nft.AssetID, nft.License,
-		nft.LicensingFee, nft.RevenueShare, nft.TwitterHandle)
+Platform: %s
+`, nft.PrimaryNFTID, nft.PrimaryNFTOwner, nft.SecondaryNFTID, nft.SecondaryNFTOwner, nft.AssetID, nft.LicenseTerms,
+		nft.LicensingFee, nft.RevenueShare, nft.TwitterHandle, nft.Platform)
 }
 
 func (nft BaseNFTPacket) ValidateBasic() error {
@@ -88,8 +100,20 @@ func (nft BaseNFTPacket) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "revenue share is empty")
 	} else if nft.TwitterHandle == "" {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "handle name is empty")
+	} else if nft.LicenseTerms == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "license terms are empty")
+	} else if err := nft.Metadata.ValidateBasic(); err != nil {
+		return err
+	} else if !nfts.IsValidPlatform(nfts.NormalizePlatform(nft.Platform)) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unknown platform %s", nft.Platform)
 	}
-	return nil
+	
+	if nft.Collection != nil {
+		if err := nft.Collection.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nft.LicenseTerms.ValidateBasic()
 }
 
 func (nft BaseNFTPacket) GetBytes() []byte {
@@ -114,22 +138,43 @@ func (nft *BaseNFTPacket) UnmarshalJSON(bytes []byte) error {
 }
 
 func (nft BaseNFTPacket) ToBaseTweetNFT() *nfts.BaseTweetNFT {
+	var collectionID string
+	if nft.Collection != nil {
+		collectionID = nft.Collection.ID
+	}
+	
 	return &nfts.BaseTweetNFT{
 		PrimaryNFTID:   nft.PrimaryNFTID,
 		PrimaryOwner:   nft.PrimaryNFTOwner,
 		SecondaryNFTID: nft.SecondaryNFTID,
 		SecondaryOwner: nft.SecondaryNFTOwner,
-		License:        nft.License,
+		LicenseTerms:   nft.LicenseTerms,
 		AssetID:        nft.AssetID,
 		LicensingFee:   nft.LicensingFee,
 		RevenueShare:   nft.RevenueShare,
 		TwitterHandle:  nft.TwitterHandle,
+		Metadata:       nft.Metadata,
+		Platform:       nfts.NormalizePlatform(nft.Platform),
+		CollectionID:   collectionID,
+		MasterNFTID:    nft.MasterNFTID,
+		EditionNumber:  nft.EditionNumber,
+		ParentNFTID:    nft.ParentNFTID,
+		Licensors:      nft.Licensors,
 	}
 }
 
+// IsSublicense reports whether the packet carries a license granted by another licensee.
+func (nft BaseNFTPacket) IsSublicense() bool {
+	return nft.ParentNFTID != "" && nft.ParentNFTID != nft.PrimaryNFTID
+}
+
 type PostCreationPacketAcknowledgement struct {
-	Success bool   `json:"success" yaml:"success"`
-	Error   string `json:"error" yaml:"error"`
+	Success        bool   `json:"success" yaml:"success"`
+	Error          string `json:"error" yaml:"error"`
+	SecondaryNFTID string `json:"secondary_nft_id" yaml:"secondary_nft_id"`
+	
+	// SecondaryNFTIDs lists the secondary nfts minted for a batch in the order of its nfts
+	SecondaryNFTIDs []string `json:"secondary_nft_ids,omitempty" yaml:"secondary_nft_ids"`
 }
 
 func (ack PostCreationPacketAcknowledgement) GetBytes() []byte {
@@ -141,6 +186,11 @@ type PacketPayLicensingFeeAndNFTTransfer struct {
 	LicensingFee sdk.Coin `json:"licensing_fee"`
 	Recipient    string   `json:"recipient"`
 	Sender       string   `json:"sender"`
+	
+	SignedOffer *SignedLicenseOffer `json:"signed_offer,omitempty"`
+	
+	// Voucher mints the primary nft on the primary chain when it has not been minted yet
+	Voucher *MintVoucher `json:"voucher,omitempty"`
 }
 
 func NewPacketPayLicensingFeeAndNFTTransfer(fee sdk.Coin, recipient, sender, primaryNFTID string) PacketPayLicensingFeeAndNFTTransfer {
@@ -168,7 +218,7 @@ LicensingFee: %s
 }
 
 func (p PacketPayLicensingFeeAndNFTTransfer) ValidateBasic() error {
-	if len(p.PrimaryNFTID) == 0 {
+	if len(p.PrimaryNFTID) == 0 && p.Voucher == nil {
 		return fmt.Errorf("invalid input field, primary nfts id")
 	}
 	
@@ -201,3 +251,574 @@ func (p *PacketPayLicensingFeeAndNFTTransfer) UnmarshalJSON(bytes []byte) error
 	*p = PacketPayLicensingFeeAndNFTTransfer(data)
 	return nil
 }
+
+type PacketLicenseExpired struct {
+	PrimaryNFTID   string `json:"primary_nft_id"`
+	SecondaryNFTID string `json:"secondary_nft_id"`
+	Licensee       string `json:"licensee"`
+}
+
+func NewPacketLicenseExpired(primaryNFTID, secondaryNFTID, licensee string) PacketLicenseExpired {
+	return PacketLicenseExpired{
+		PrimaryNFTID:   primaryNFTID,
+		SecondaryNFTID: secondaryNFTID,
+		Licensee:       licensee,
+	}
+}
+
+var _ XNFTs = PacketLicenseExpired{}
+
+func (p PacketLicenseExpired) GetBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(p))
+}
+
+func (p PacketLicenseExpired) String() string {
+	return fmt.Sprintf(`
+PrimaryNFTID: %s,
+SecondaryNFTID: %s,
+Licensee: %s
+`, p.PrimaryNFTID, p.SecondaryNFTID, p.Licensee)
+}
+
+func (p PacketLicenseExpired) ValidateBasic() error {
+	if len(p.PrimaryNFTID) == 0 {
+		return fmt.Errorf("invalid input field, primary nfts id")
+	}
+	if len(p.SecondaryNFTID) == 0 {
+		return fmt.Errorf("invalid input field, secondary nfts id")
+	}
+	return nil
+}
+
+func (p PacketLicenseExpired) MarshalJSON() ([]byte, error) {
+	type tmp PacketLicenseExpired
+	return json.Marshal(tmp(p))
+}
+
+func (p *PacketLicenseExpired) UnmarshalJSON(bytes []byte) error {
+	type tmp PacketLicenseExpired
+	var data tmp
+	
+	if err := json.Unmarshal(bytes, &data); err != nil {
+		return err
+	}
+	
+	*p = PacketLicenseExpired(data)
+	return nil
+}
+
+type PacketSubscriptionPayment struct {
+	PrimaryNFTID   string   `json:"primary_nft_id"`
+	SecondaryNFTID string   `json:"secondary_nft_id"`
+	Fee            sdk.Coin `json:"fee"`
+	Sender         string   `json:"sender"`
+	Recipient      string   `json:"recipient"`
+}
+
+func NewPacketSubscriptionPayment(primaryNFTID, secondaryNFTID string, fee sdk.Coin, sender, recipient string) PacketSubscriptionPayment {
+	return PacketSubscriptionPayment{
+		PrimaryNFTID:   primaryNFTID,
+		SecondaryNFTID: secondaryNFTID,
+		Fee:            fee,
+		Sender:         sender,
+		Recipient:      recipient,
+	}
+}
+
+var _ XNFTs = PacketSubscriptionPayment{}
+
+func (p PacketSubscriptionPayment) GetBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(p))
+}
+
+func (p PacketSubscriptionPayment) String() string {
+	return fmt.Sprintf(`
+PrimaryNFTID: %s,
+SecondaryNFTID: %s,
+Fee: %s,
+Sender: %s,
+Recipient: %s
+`, p.PrimaryNFTID, p.SecondaryNFTID, p.Fee, p.Sender, p.Recipient)
+}
+
+func (p PacketSubscriptionPayment) ValidateBasic() error {
+	if len(p.PrimaryNFTID) == 0 {
+		return fmt.Errorf("invalid input field, primary nfts id")
+	}
+	if len(p.SecondaryNFTID) == 0 {
+		return fmt.Errorf("invalid input field, secondary nfts id")
+	}
+	if p.Fee.IsZero() {
+		return fmt.Errorf("invalid subscription fee")
+	}
+	if len(p.Recipient) == 0 {
+		return fmt.Errorf("invalid input field, recipient address")
+	}
+	if len(p.Sender) == 0 {
+		return fmt.Errorf("invalid input field, sender address")
+	}
+	return nil
+}
+
+func (p PacketSubscriptionPayment) MarshalJSON() ([]byte, error) {
+	type tmp PacketSubscriptionPayment
+	return json.Marshal(tmp(p))
+}
+
+func (p *PacketSubscriptionPayment) UnmarshalJSON(bytes []byte) error {
+	type tmp PacketSubscriptionPayment
+	var data tmp
+	
+	if err := json.Unmarshal(bytes, &data); err != nil {
+		return err
+	}
+	
+	*p = PacketSubscriptionPayment(data)
+	return nil
+}
+
+type PacketLicenseOffer struct {
+	OfferID        uint64    `json:"offer_id"`
+	PrimaryNFTID   string    `json:"primary_nft_id"`
+	Fee            sdk.Coin  `json:"fee"`
+	RevenueShare   sdk.Dec   `json:"revenue_share"`
+	DurationBlocks int64     `json:"duration_blocks"`
+	Expiry         time.Time `json:"expiry"`
+	Sender         string    `json:"sender"`
+	Recipient      string    `json:"recipient"`
+}
+
+func NewPacketLicenseOffer(offer LicenseOffer) PacketLicenseOffer {
+	return PacketLicenseOffer{
+		OfferID:        offer.ID,
+		PrimaryNFTID:   offer.PrimaryNFTID,
+		Fee:            offer.Fee,
+		RevenueShare:   offer.RevenueShare,
+		DurationBlocks: offer.DurationBlocks,
+		Expiry:         offer.Expiry,
+		Sender:         offer.Offerer,
+		Recipient:      offer.Recipient,
+	}
+}
+
+var _ XNFTs = PacketLicenseOffer{}
+
+func (p PacketLicenseOffer) GetBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(p))
+}
+
+func (p PacketLicenseOffer) String() string {
+	return fmt.Sprintf(`
+OfferID: %d,
+PrimaryNFTID: %s,
+Fee: %s,
+RevenueShare: %s,
+DurationBlocks: %d,
+Expiry: %s,
+Sender: %s,
+Recipient: %s
+`, p.OfferID, p.PrimaryNFTID, p.Fee, p.RevenueShare, p.DurationBlocks, p.Expiry, p.Sender, p.Recipient)
+}
+
+func (p PacketLicenseOffer) ValidateBasic() error {
+	if len(p.PrimaryNFTID) == 0 {
+		return fmt.Errorf("invalid input field, primary nfts id")
+	}
+	if p.Fee.IsZero() {
+		return fmt.Errorf("invalid offer fee")
+	}
+	if p.RevenueShare.IsNil() || p.RevenueShare.IsNegative() {
+		return fmt.Errorf("invalid revenue share")
+	}
+	if p.DurationBlocks < 0 {
+		return fmt.Errorf("invalid license duration")
+	}
+	if len(p.Recipient) == 0 {
+		return fmt.Errorf("invalid input field, recipient address")
+	}
+	if len(p.Sender) == 0 {
+		return fmt.Errorf("invalid input field, sender address")
+	}
+	return nil
+}
+
+func (p PacketLicenseOffer) ToLicenseOffer(port, channel string) LicenseOffer {
+	return LicenseOffer{
+		ID:             p.OfferID,
+		PrimaryNFTID:   p.PrimaryNFTID,
+		Offerer:        p.Sender,
+		Recipient:      p.Recipient,
+		Fee:            p.Fee,
+		RevenueShare:   p.RevenueShare,
+		DurationBlocks: p.DurationBlocks,
+		Expiry:         p.Expiry,
+		Port:           port,
+		Channel:        channel,
+		Status:         OfferStatusPending,
+	}
+}
+
+func (p PacketLicenseOffer) MarshalJSON() ([]byte, error) {
+	type tmp PacketLicenseOffer
+	return json.Marshal(tmp(p))
+}
+
+func (p *PacketLicenseOffer) UnmarshalJSON(bytes []byte) error {
+	type tmp PacketLicenseOffer
+	var data tmp
+	
+	if err := json.Unmarshal(bytes, &data); err != nil {
+		return err
+	}
+	
+	*p = PacketLicenseOffer(data)
+	return nil
+}
+
+type PacketLicenseOfferResponse struct {
+	OfferID      uint64 `json:"offer_id"`
+	PrimaryNFTID string `json:"primary_nft_id"`
+	Accepted     bool   `json:"accepted"`
+	
+	// Refund returns the released escrow of an accepted offer whose license could not be granted
+	Refund bool `json:"refund,omitempty"`
+}
+
+func NewPacketLicenseOfferResponse(offerID uint64, primaryNFTID string, accepted bool) PacketLicenseOfferResponse {
+	return PacketLicenseOfferResponse{
+		OfferID:      offerID,
+		PrimaryNFTID: primaryNFTID,
+		Accepted:     accepted,
+	}
+}
+
+var _ XNFTs = PacketLicenseOfferResponse{}
+
+func (p PacketLicenseOfferResponse) GetBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(p))
+}
+
+func (p PacketLicenseOfferResponse) String() string {
+	return fmt.Sprintf(`
+OfferID: %d,
+PrimaryNFTID: %s,
+Accepted: %t,
+Refund: %t
+`, p.OfferID, p.PrimaryNFTID, p.Accepted, p.Refund)
+}
+
+func (p PacketLicenseOfferResponse) MarshalJSON() ([]byte, error) {
+	type tmp PacketLicenseOfferResponse
+	return json.Marshal(tmp(p))
+}
+
+func (p *PacketLicenseOfferResponse) UnmarshalJSON(bytes []byte) error {
+	type tmp PacketLicenseOfferResponse
+	var data tmp
+	
+	if err := json.Unmarshal(bytes, &data); err != nil {
+		return err
+	}
+	
+	*p = PacketLicenseOfferResponse(data)
+	return nil
+}
+
+type PacketBuySublicense struct {
+	ParentNFTID string   `json:"parent_nft_id"`
+	Fee         sdk.Coin `json:"fee"`
+	Sender      string   `json:"sender"`
+}
+
+func NewPacketBuySublicense(parentNFTID string, fee sdk.Coin, sender string) PacketBuySublicense {
+	return PacketBuySublicense{
+		ParentNFTID: parentNFTID,
+		Fee:         fee,
+		Sender:      sender,
+	}
+}
+
+var _ XNFTs = PacketBuySublicense{}
+
+func (p PacketBuySublicense) GetBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(p))
+}
+
+func (p PacketBuySublicense) String() string {
+	return fmt.Sprintf(`
+ParentNFTID: %s,
+Fee: %s,
+Sender: %s
+`, p.ParentNFTID, p.Fee, p.Sender)
+}
+
+func (p PacketBuySublicense) ValidateBasic() error {
+	if len(p.ParentNFTID) == 0 {
+		return fmt.Errorf("invalid input field, parent nft id")
+	}
+	if !p.Fee.IsValid() || p.Fee.IsZero() {
+		return fmt.Errorf("invalid sublicense fee")
+	}
+	if len(p.Sender) == 0 {
+		return fmt.Errorf("invalid input field, sender address")
+	}
+	return nil
+}
+
+func (p PacketBuySublicense) MarshalJSON() ([]byte, error) {
+	type tmp PacketBuySublicense
+	return json.Marshal(tmp(p))
+}
+
+func (p *PacketBuySublicense) UnmarshalJSON(bytes []byte) error {
+	type tmp PacketBuySublicense
+	var data tmp
+	
+	if err := json.Unmarshal(bytes, &data); err != nil {
+		return err
+	}
+	
+	*p = PacketBuySublicense(data)
+	return nil
+}
+
+// PacketSublicenseRevenue carries the revenue share a licensee owes to a licensor living on
+// another chain, Sender is refunded when the licensor chain can not take it.
+type PacketSublicenseRevenue struct {
+	NFTID  string   `json:"nft_id"`
+	Amount sdk.Coin `json:"amount"`
+	Sender string   `json:"sender"`
+}
+
+func NewPacketSublicenseRevenue(nftID string, amount sdk.Coin, sender string) PacketSublicenseRevenue {
+	return PacketSublicenseRevenue{
+		NFTID:  nftID,
+		Amount: amount,
+		Sender: sender,
+	}
+}
+
+var _ XNFTs = PacketSublicenseRevenue{}
+
+func (p PacketSublicenseRevenue) GetBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(p))
+}
+
+func (p PacketSublicenseRevenue) String() string {
+	return fmt.Sprintf(`
+NFTID: %s,
+Amount: %s,
+Sender: %s
+`, p.NFTID, p.Amount, p.Sender)
+}
+
+func (p PacketSublicenseRevenue) ValidateBasic() error {
+	if len(p.NFTID) == 0 {
+		return fmt.Errorf("invalid input field, nft id")
+	}
+	if !p.Amount.IsValid() || p.Amount.IsZero() {
+		return fmt.Errorf("invalid revenue amount")
+	}
+	if len(p.Sender) == 0 {
+		return fmt.Errorf("invalid input field, sender address")
+	}
+	return nil
+}
+
+func (p PacketSublicenseRevenue) MarshalJSON() ([]byte, error) {
+	type tmp PacketSublicenseRevenue
+	return json.Marshal(tmp(p))
+}
+
+func (p *PacketSublicenseRevenue) UnmarshalJSON(bytes []byte) error {
+	type tmp PacketSublicenseRevenue
+	var data tmp
+	
+	if err := json.Unmarshal(bytes, &data); err != nil {
+		return err
+	}
+	
+	*p = PacketSublicenseRevenue(data)
+	return nil
+}
+
+// PacketLicenseTransferred tells the primary chain that a license it granted changed hands on
+// the licensee chain.
+type PacketLicenseTransferred struct {
+	PrimaryNFTID   string `json:"primary_nft_id"`
+	SecondaryNFTID string `json:"secondary_nft_id"`
+	Sender         string `json:"sender"`
+	Recipient      string `json:"recipient"`
+}
+
+func NewPacketLicenseTransferred(primaryNFTID, secondaryNFTID, sender, recipient string) PacketLicenseTransferred {
+	return PacketLicenseTransferred{
+		PrimaryNFTID:   primaryNFTID,
+		SecondaryNFTID: secondaryNFTID,
+		Sender:         sender,
+		Recipient:      recipient,
+	}
+}
+
+var _ XNFTs = PacketLicenseTransferred{}
+
+func (p PacketLicenseTransferred) GetBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(p))
+}
+
+func (p PacketLicenseTransferred) String() string {
+	return fmt.Sprintf(`
+PrimaryNFTID: %s,
+SecondaryNFTID: %s,
+Sender: %s,
+Recipient: %s
+`, p.PrimaryNFTID, p.SecondaryNFTID, p.Sender, p.Recipient)
+}
+
+func (p PacketLicenseTransferred) ValidateBasic() error {
+	if len(p.PrimaryNFTID) == 0 {
+		return fmt.Errorf("invalid input field, primary nfts id")
+	}
+	if len(p.SecondaryNFTID) == 0 {
+		return fmt.Errorf("invalid input field, secondary nfts id")
+	}
+	if len(p.Sender) == 0 {
+		return fmt.Errorf("invalid input field, sender address")
+	}
+	if len(p.Recipient) == 0 {
+		return fmt.Errorf("invalid input field, recipient address")
+	}
+	return nil
+}
+
+func (p PacketLicenseTransferred) MarshalJSON() ([]byte, error) {
+	type tmp PacketLicenseTransferred
+	return json.Marshal(tmp(p))
+}
+
+func (p *PacketLicenseTransferred) UnmarshalJSON(bytes []byte) error {
+	type tmp PacketLicenseTransferred
+	var data tmp
+	
+	if err := json.Unmarshal(bytes, &data); err != nil {
+		return err
+	}
+	
+	*p = PacketLicenseTransferred(data)
+	return nil
+}
+
+// PacketBatchNFTTransfer licenses many primary nfts to a single recipient, the receiving chain
+// mints either all or none of the secondary nfts.
+type PacketBatchNFTTransfer struct {
+	NFTs []BaseNFTPacket `json:"nfts"`
+}
+
+func NewPacketBatchNFTTransfer(packets []BaseNFTPacket) PacketBatchNFTTransfer {
+	return PacketBatchNFTTransfer{
+		NFTs: packets,
+	}
+}
+
+var _ XNFTs = PacketBatchNFTTransfer{}
+
+func (p PacketBatchNFTTransfer) GetBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(p))
+}
+
+func (p PacketBatchNFTTransfer) String() string {
+	var str string
+	for _, nft := range p.NFTs {
+		str += nft.String()
+	}
+	return str
+}
+
+func (p PacketBatchNFTTransfer) ValidateBasic() error {
+	if len(p.NFTs) == 0 {
+		return fmt.Errorf("invalid input field, nfts")
+	}
+	
+	seen := make(map[string]bool)
+	for _, nft := range p.NFTs {
+		if len(nft.PrimaryNFTID) == 0 {
+			return fmt.Errorf("invalid input field, primary nfts id")
+		} else if seen[nft.PrimaryNFTID] {
+			return fmt.Errorf("duplicate primary nfts id %s", nft.PrimaryNFTID)
+		}
+		seen[nft.PrimaryNFTID] = true
+	}
+	return nil
+}
+
+func (p PacketBatchNFTTransfer) MarshalJSON() ([]byte, error) {
+	type tmp PacketBatchNFTTransfer
+	return json.Marshal(tmp(p))
+}
+
+func (p *PacketBatchNFTTransfer) UnmarshalJSON(bytes []byte) error {
+	type tmp PacketBatchNFTTransfer
+	var data tmp
+	
+	if err := json.Unmarshal(bytes, &data); err != nil {
+		return err
+	}
+	
+	*p = PacketBatchNFTTransfer(data)
+	return nil
+}
+
+type PacketPayBundleLicensingFee struct {
+	BundleID     uint64   `json:"bundle_id"`
+	LicensingFee sdk.Coin `json:"licensing_fee"`
+	Sender       string   `json:"sender"`
+}
+
+func NewPacketPayBundleLicensingFee(bundleID uint64, fee sdk.Coin, sender string) PacketPayBundleLicensingFee {
+	return PacketPayBundleLicensingFee{
+		BundleID:     bundleID,
+		LicensingFee: fee,
+		Sender:       sender,
+	}
+}
+
+var _ XNFTs = PacketPayBundleLicensingFee{}
+
+func (p PacketPayBundleLicensingFee) GetBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(p))
+}
+
+func (p PacketPayBundleLicensingFee) String() string {
+	return fmt.Sprintf(`
+BundleID: %d,
+LicensingFee: %s,
+Sender: %s
+`, p.BundleID, p.LicensingFee, p.Sender)
+}
+
+func (p PacketPayBundleLicensingFee) ValidateBasic() error {
+	if !p.LicensingFee.IsValid() || p.LicensingFee.IsZero() {
+		return fmt.Errorf("invalid licensing fee")
+	}
+	if len(p.Sender) == 0 {
+		return fmt.Errorf("invalid input field, sender address")
+	}
+	return nil
+}
+
+func (p PacketPayBundleLicensingFee) MarshalJSON() ([]byte, error) {
+	type tmp PacketPayBundleLicensingFee
+	return json.Marshal(tmp(p))
+}
+
+func (p *PacketPayBundleLicensingFee) UnmarshalJSON(bytes []byte) error {
+	type tmp PacketPayBundleLicensingFee
+	var data tmp
+	
+	if err := json.Unmarshal(bytes, &data); err != nil {
+		return err
+	}
+	
+	*p = PacketPayBundleLicensingFee(data)
+	return nil
+}
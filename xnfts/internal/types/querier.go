@@ -0,0 +1,6 @@
+package types
+
+const (
+	QueryLicenseOffers = "license_offers"
+	QueryMintVoucher   = "mint_voucher"
+)
@@ -0,0 +1,171 @@
+package types
+
+import (
+	"fmt"
+	"time"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+// SignedOfferTerms are published by the primary owner, an empty licensee leaves the offer open to anyone
+// and a zero max redemptions lets it be redeemed until it expires.
+type SignedOfferTerms struct {
+	PrimaryNFTID string `json:"primary_nft_id"`
+	Owner        string `json:"owner"`
+	Licensee     string `json:"licensee"`
+	
+	Fee             sdk.Coin `json:"fee"`
+	DurationBlocks  int64    `json:"duration_blocks"`
+	DurationSeconds int64    `json:"duration_seconds"`
+	
+	MaxRedemptions uint64    `json:"max_redemptions"`
+	Nonce          uint64    `json:"nonce"`
+	Expiry         time.Time `json:"expiry"`
+	
+	// ChainID is the primary chain the offer is redeemed on
+	ChainID string `json:"chain_id"`
+}
+
+func NewSignedOfferTerms(primaryNFTID, owner, licensee string, fee sdk.Coin, durationBlocks, durationSeconds int64,
+	maxRedemptions, nonce uint64, expiry time.Time, chainID string) SignedOfferTerms {
+	return SignedOfferTerms{
+		PrimaryNFTID:    primaryNFTID,
+		Owner:           owner,
+		Licensee:        licensee,
+		Fee:             fee,
+		DurationBlocks:  durationBlocks,
+		DurationSeconds: durationSeconds,
+		MaxRedemptions:  maxRedemptions,
+		Nonce:           nonce,
+		Expiry:          expiry,
+		ChainID:         chainID,
+	}
+}
+
+const (
+	SignTypeLicenseOffer = "xnfts/license_offer"
+	SignTypeMintVoucher  = "xnfts/mint_voucher"
+)
+
+// getSignBytes tags the terms with their type, terms signed for one purpose can not be
+// redeemed as terms of another.
+func getSignBytes(signType string, terms interface{}) []byte {
+	return sdk.MustSortJSON([]byte(fmt.Sprintf(`{"terms":%s,"type":%q}`, ModuleCdc.MustMarshalJSON(terms), signType)))
+}
+
+func (t SignedOfferTerms) GetSignBytes() []byte {
+	return getSignBytes(SignTypeLicenseOffer, t)
+}
+
+func (t SignedOfferTerms) ValidateBasic() error {
+	if len(t.PrimaryNFTID) == 0 {
+		return fmt.Errorf("invalid input field, primary nfts id")
+	}
+	if len(t.Owner) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing owner address")
+	}
+	if !t.Fee.IsValid() || t.Fee.IsZero() {
+		return sdkerrors.ErrInvalidCoins
+	}
+	if t.DurationBlocks < 0 || t.DurationSeconds < 0 {
+		return sdkerrors.Wrap(ErrInvalidLicenseOffer, "license duration can not be negative")
+	}
+	if t.DurationBlocks > 0 && t.DurationSeconds > 0 {
+		return sdkerrors.Wrap(ErrInvalidLicenseOffer, "license duration can be set either in blocks or in seconds")
+	}
+	if t.Expiry.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidLicenseOffer, "missing offer expiry")
+	}
+	if len(t.ChainID) == 0 {
+		return sdkerrors.Wrap(ErrInvalidLicenseOffer, "missing chain id")
+	}
+	return nil
+}
+
+type SignedLicenseOffer struct {
+	Terms     SignedOfferTerms `json:"terms"`
+	PubKey    crypto.PubKey    `json:"pub_key"`
+	Signature []byte           `json:"signature"`
+}
+
+func NewSignedLicenseOffer(terms SignedOfferTerms, pubKey crypto.PubKey, signature []byte) SignedLicenseOffer {
+	return SignedLicenseOffer{
+		Terms:     terms,
+		PubKey:    pubKey,
+		Signature: signature,
+	}
+}
+
+func (o SignedLicenseOffer) Signer() sdk.AccAddress {
+	return sdk.AccAddress(o.PubKey.Address())
+}
+
+func (o SignedLicenseOffer) VerifySignature() bool {
+	return o.PubKey != nil && o.PubKey.VerifyBytes(o.Terms.GetSignBytes(), o.Signature)
+}
+
+func (o SignedLicenseOffer) IsExpired(now time.Time) bool {
+	return !now.Before(o.Terms.Expiry)
+}
+
+func (o SignedLicenseOffer) IsLicenseeAllowed(licensee string) bool {
+	return len(o.Terms.Licensee) == 0 || o.Terms.Licensee == licensee
+}
+
+func (o SignedLicenseOffer) ValidateBasic() error {
+	if err := o.Terms.ValidateBasic(); err != nil {
+		return err
+	}
+	if !o.VerifySignature() {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "invalid offer signature")
+	}
+	return nil
+}
+
+func (o SignedLicenseOffer) String() string {
+	return fmt.Sprintf(`
+PrimaryNFTID: %s,
+Owner: %s,
+Licensee: %s,
+Fee: %s,
+DurationBlocks: %d,
+DurationSeconds: %d,
+MaxRedemptions: %d,
+Nonce: %d,
+Expiry: %s,
+ChainID: %s,
+Signer: %s
+`, o.Terms.PrimaryNFTID, o.Terms.Owner, o.Terms.Licensee, o.Terms.Fee, o.Terms.DurationBlocks,
+		o.Terms.DurationSeconds, o.Terms.MaxRedemptions, o.Terms.Nonce, o.Terms.Expiry, o.Terms.ChainID, o.Signer())
+}
+
+type SignedOfferRedemption struct {
+	Owner string `json:"owner"`
+	Nonce uint64 `json:"nonce"`
+	Count uint64 `json:"count"`
+}
+
+// RevokedSignedOffer is a nonce the owner withdrew, offers signed with it can not be redeemed.
+type RevokedSignedOffer struct {
+	Owner string `json:"owner"`
+	Nonce uint64 `json:"nonce"`
+}
+
+// SignedLicenseOfferInspection is the signed offer with the checks a licensee makes before redeeming it.
+type SignedLicenseOfferInspection struct {
+	Offer          SignedLicenseOffer `json:"offer" yaml:"offer"`
+	Signer         sdk.AccAddress     `json:"signer" yaml:"signer"`
+	ValidSignature bool               `json:"valid_signature" yaml:"valid_signature"`
+	Expired        bool               `json:"expired" yaml:"expired"`
+}
+
+func NewSignedLicenseOfferInspection(offer SignedLicenseOffer, now time.Time) SignedLicenseOfferInspection {
+	return SignedLicenseOfferInspection{
+		Offer:          offer,
+		Signer:         offer.Signer(),
+		ValidSignature: offer.VerifySignature(),
+		Expired:        offer.IsExpired(now),
+	}
+}
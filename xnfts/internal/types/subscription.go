@@ -0,0 +1,55 @@
+package types
+
+import (
+	"fmt"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Subscription pays the licensing fee of a primary nft once every period out of a deposit
+// held on the licensee chain. It stays pending until the secondary nft is received.
+type Subscription struct {
+	PrimaryNFTID   string `json:"primary_nft_id"`
+	SecondaryNFTID string `json:"secondary_nft_id"`
+	Subscriber     string `json:"subscriber"`
+	Recipient      string `json:"recipient"`
+	
+	Fee     sdk.Coin `json:"fee"`
+	Deposit sdk.Coin `json:"deposit"`
+	
+	PeriodBlocks      int64 `json:"period_blocks"`
+	NextPaymentHeight int64 `json:"next_payment_height"`
+	
+	Port    string `json:"port"`
+	Channel string `json:"channel"`
+}
+
+func NewSubscription(primaryNFTID, subscriber, recipient string, fee, deposit sdk.Coin) Subscription {
+	return Subscription{
+		PrimaryNFTID: primaryNFTID,
+		Subscriber:   subscriber,
+		Recipient:    recipient,
+		Fee:          fee,
+		Deposit:      deposit,
+	}
+}
+
+func (s Subscription) IsActive() bool {
+	return s.SecondaryNFTID != ""
+}
+
+func (s Subscription) String() string {
+	return fmt.Sprintf(`
+PrimaryNFTID: %s,
+SecondaryNFTID: %s,
+Subscriber: %s,
+Recipient: %s,
+Fee: %s,
+Deposit: %s,
+PeriodBlocks: %d,
+NextPaymentHeight: %d,
+Port: %s,
+Channel: %s
+`, s.PrimaryNFTID, s.SecondaryNFTID, s.Subscriber, s.Recipient, s.Fee, s.Deposit,
+		s.PeriodBlocks, s.NextPaymentHeight, s.Port, s.Channel)
+}
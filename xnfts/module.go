@@ -59,7 +59,7 @@ func (AppModuleBasic) GetTxCmd(cdc *codec.Codec) *cobra.Command {
 }
 
 func (AppModuleBasic) GetQueryCmd(cdc *codec.Codec) *cobra.Command {
-	return nil
+	return cli.GetQueryCmd(cdc)
 }
 
 func (AppModuleBasic) RegisterInterfaceTypes(registry cdctypes.InterfaceRegistry) {
@@ -94,7 +94,7 @@ func (AppModule) QuerierRoute() string {
 }
 
 func (am AppModule) NewQuerierHandler() sdk.Querier {
-	return nil
+	return NewQuerier(am.keeper)
 }
 
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONMarshaler, data json.RawMessage) []abci.ValidatorUpdate {
@@ -115,6 +115,7 @@ func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
 }
 
 func (am AppModule) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.ValidatorUpdate {
+	EndBlocker(ctx, am.keeper)
 	return []abci.ValidatorUpdate{}
 }
 
@@ -238,6 +239,24 @@ func (am AppModule) OnRecvPacket(
 		return handleXNFTRecvPacket(ctx, am.keeper, packet)
 	case PacketPayLicensingFeeAndNFTTransfer:
 		return handlePayLicensingFeeAndNFTTransferRecvPacket(ctx, am.keeper, packet)
+	case PacketLicenseExpired:
+		return handleLicenseExpiredRecvPacket(ctx, am.keeper, packet, data)
+	case PacketSubscriptionPayment:
+		return handleSubscriptionPaymentRecvPacket(ctx, am.keeper, packet, data)
+	case PacketLicenseOffer:
+		return handleLicenseOfferRecvPacket(ctx, am.keeper, packet, data)
+	case PacketLicenseOfferResponse:
+		return handleLicenseOfferResponseRecvPacket(ctx, am.keeper, packet, data)
+	case PacketBuySublicense:
+		return handleBuySublicenseRecvPacket(ctx, am.keeper, packet, data)
+	case PacketSublicenseRevenue:
+		return handleSublicenseRevenueRecvPacket(ctx, am.keeper, packet, data)
+	case PacketLicenseTransferred:
+		return handleLicenseTransferredRecvPacket(ctx, am.keeper, packet, data)
+	case PacketBatchNFTTransfer:
+		return handleBatchNFTTransferRecvPacket(ctx, am.keeper, packet, data)
+	case PacketPayBundleLicensingFee:
+		return handlePayBundleLicensingFeeRecvPacket(ctx, am.keeper, packet, data)
 	default:
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized ICS-20 transfer message type: %T", data)
 		
@@ -250,6 +269,74 @@ func (am AppModule) OnAcknowledgementPacket(
 	packet channeltypes.Packet,
 	acknowledgement []byte,
 ) (*sdk.Result, error) {
+	var ack PostCreationPacketAcknowledgement
+	if err := types.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 xnft packet acknowledgement: %s", err.Error())
+	}
+	
+	var data XNFTs
+	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 xnft packet data: %s", err.Error())
+	}
+	
+	switch data := data.(type) {
+	case BaseNFTPacket:
+		am.keeper.OnAcknowledgementNFTPacket(ctx, data, ack, packet)
+		if err := am.keeper.OnAcknowledgementAuctionGrant(ctx, ack, packet); err != nil {
+			return nil, err
+		}
+	case PacketPayLicensingFeeAndNFTTransfer:
+		if !ack.Success {
+			if err := am.keeper.RefundLicensingFee(ctx, data); err != nil {
+				return nil, err
+			}
+			if err := am.keeper.RefundPendingSubscription(ctx, data); err != nil {
+				return nil, err
+			}
+		}
+	case PacketLicenseExpired:
+		am.keeper.OnAcknowledgementLicenseExpired(ctx, data, ack)
+	case PacketSubscriptionPayment:
+		if !ack.Success {
+			if err := am.keeper.LapseRejectedSubscription(ctx, data); err != nil {
+				return nil, err
+			}
+		}
+	case PacketLicenseOffer:
+		if !ack.Success {
+			if err := am.keeper.OnFailedLicenseOffer(ctx, data, packet, OfferStatusRejected); err != nil {
+				return nil, err
+			}
+		}
+	case PacketLicenseOfferResponse:
+		if err := am.keeper.OnAcknowledgementLicenseOfferResponse(ctx, data, ack, packet); err != nil {
+			return nil, err
+		}
+	case PacketBuySublicense:
+		if !ack.Success {
+			if err := am.keeper.RefundSublicenseFee(ctx, data); err != nil {
+				return nil, err
+			}
+		}
+	case PacketSublicenseRevenue:
+		if !ack.Success {
+			if err := am.keeper.RefundSublicenseRevenue(ctx, data); err != nil {
+				return nil, err
+			}
+		}
+	case PacketLicenseTransferred:
+		if !ack.Success {
+			am.keeper.RevertLicenseTransfer(ctx, data)
+		}
+	case PacketBatchNFTTransfer:
+		am.keeper.OnAcknowledgementBatchNFTTransfer(ctx, data, ack, packet)
+	case PacketPayBundleLicensingFee:
+		if !ack.Success {
+			if err := am.keeper.RefundBundleLicensingFee(ctx, data); err != nil {
+				return nil, err
+			}
+		}
+	}
 	
 	return &sdk.Result{
 		Events: ctx.EventManager().Events().ToABCIEvents(),
@@ -260,6 +347,53 @@ func (am AppModule) OnTimeoutPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
 ) (*sdk.Result, error) {
+	var data XNFTs
+	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 xnft packet data: %s", err.Error())
+	}
+	
+	switch data := data.(type) {
+	case BaseNFTPacket:
+		am.keeper.OnTimeoutNFTPacket(ctx, data, packet)
+		if err := am.keeper.OnTimeoutAuctionGrant(ctx, packet); err != nil {
+			return nil, err
+		}
+	case PacketPayLicensingFeeAndNFTTransfer:
+		if err := am.keeper.RefundLicensingFee(ctx, data); err != nil {
+			return nil, err
+		}
+		if err := am.keeper.RefundPendingSubscription(ctx, data); err != nil {
+			return nil, err
+		}
+	case PacketLicenseExpired:
+		am.keeper.OnTimeoutLicenseExpired(ctx, data, packet)
+	case PacketSubscriptionPayment:
+		if err := am.keeper.RefundSubscriptionPayment(ctx, data); err != nil {
+			return nil, err
+		}
+	case PacketLicenseOffer:
+		if err := am.keeper.OnFailedLicenseOffer(ctx, data, packet, OfferStatusExpired); err != nil {
+			return nil, err
+		}
+	case PacketLicenseOfferResponse:
+		am.keeper.OnTimeoutLicenseOfferResponse(ctx, data, packet)
+	case PacketBuySublicense:
+		if err := am.keeper.RefundSublicenseFee(ctx, data); err != nil {
+			return nil, err
+		}
+	case PacketSublicenseRevenue:
+		if err := am.keeper.RefundSublicenseRevenue(ctx, data); err != nil {
+			return nil, err
+		}
+	case PacketLicenseTransferred:
+		am.keeper.RevertLicenseTransfer(ctx, data)
+	case PacketBatchNFTTransfer:
+		am.keeper.OnTimeoutBatchNFTTransfer(ctx, data, packet)
+	case PacketPayBundleLicensingFee:
+		if err := am.keeper.RefundBundleLicensingFee(ctx, data); err != nil {
+			return nil, err
+		}
+	}
 	
 	return &sdk.Result{
 		Events: ctx.EventManager().Events().ToABCIEvents(),